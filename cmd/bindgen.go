@@ -0,0 +1,34 @@
+package main
+
+import (
+	"github.com/godot-go/godot-go/cmd/generate/bindgen"
+
+	"github.com/spf13/cobra"
+)
+
+var bindgenCmd = &cobra.Command{
+	Use:   "bindgen [dir...]",
+	Short: "Generate typed method trampolines for the Go classes of a package",
+	Long: `bindgen writes ` + bindgen.OutputFileName + ` next to the Go classes of each
+package directory (the current directory by default). The generated init
+function registers trampolines that call the bound methods without
+reflection; rerun it after changing the signature of a bound method.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			args = []string{"."}
+		}
+		for _, dir := range args {
+			if verbose {
+				println("Generating method trampolines for " + dir + "...")
+			}
+			if err := bindgen.Generate(dir); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(bindgenCmd)
+}
@@ -260,6 +260,18 @@ type Class struct {
 	Properties     []ClassProperty `json:"properties"`
 }
 
+func (a Class) VirtualMethods() []ClassMethod {
+	values := make([]ClassMethod, 0, len(a.Methods))
+
+	for _, m := range a.Methods {
+		if m.IsVirtual {
+			values = append(values, m)
+		}
+	}
+
+	return values
+}
+
 func (a Class) FilteredEnums() []Enum {
 	values := make([]Enum, 0, len(a.Enums))
 
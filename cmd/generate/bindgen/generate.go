@@ -0,0 +1,327 @@
+package bindgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	_ "embed"
+)
+
+var (
+	//go:embed bindings.go.tmpl
+	bindingsText string
+)
+
+// OutputFileName is the file Generate writes the trampolines of a package to.
+const OutputFileName = "bindings.gen.go"
+
+// skippedMethods are called by the runtime directly instead of being bound.
+var skippedMethods = map[string]struct{}{
+	"GetClassName":       {},
+	"GetParentClassName": {},
+	"V_Notification":     {},
+	"V_SerializeState":   {},
+	"V_RestoreState":     {},
+	"V_Reference":        {},
+	"V_Unreference":      {},
+	"V_GetPropertyList":  {},
+}
+
+// builtinPkgPath is the import path of the package declaring the engine
+// types and their encoders.
+const builtinPkgPath = "github.com/godot-go/godot-go/pkg/builtin"
+
+// basicEncoders maps the predeclared Go types a trampoline can convert to the
+// name of their encoder in pkg/builtin.
+var basicEncoders = map[string]string{
+	"bool":    "BoolEncoder",
+	"int":     "IntEncoder",
+	"int8":    "Int8Encoder",
+	"int16":   "Int16Encoder",
+	"int32":   "Int32Encoder",
+	"int64":   "Int64Encoder",
+	"uint":    "UintEncoder",
+	"uint8":   "Uint8Encoder",
+	"uint16":  "Uint16Encoder",
+	"uint32":  "Uint32Encoder",
+	"uint64":  "Uint64Encoder",
+	"float32": "Float32Encoder",
+	"float64": "Float64Encoder",
+	"string":  "GoStringUtf8Encoder",
+}
+
+// builtinEncoders maps the types declared in pkg/builtin a trampoline can
+// convert to the name of their encoder.
+var builtinEncoders = map[string]string{}
+
+func init() {
+	for _, name := range []string{
+		"Variant", "String", "StringName", "NodePath", "RID", "Callable", "Signal",
+		"Vector2", "Vector2i", "Rect2", "Rect2i", "Vector3", "Vector3i",
+		"Transform2D", "Vector4", "Vector4i", "Plane", "Quaternion", "AABB",
+		"Basis", "Transform3D", "Projection", "Color", "Dictionary", "Array",
+		"PackedByteArray", "PackedInt32Array", "PackedInt64Array",
+		"PackedFloat32Array", "PackedFloat64Array", "PackedStringArray",
+		"PackedVector2Array", "PackedVector3Array", "PackedColorArray",
+		"PackedVector4Array",
+	} {
+		builtinEncoders[name] = name + "Encoder"
+	}
+}
+
+// fileScope resolves the type names used in the method signatures of a file.
+type fileScope struct {
+	// localTypes are the types declared by the package, which shadow
+	// predeclared and dot-imported names.
+	localTypes map[string]struct{}
+	// builtinNames are the names pkg/builtin is imported as.
+	builtinNames map[string]struct{}
+	// dotBuiltin is set when pkg/builtin is dot-imported.
+	dotBuiltin bool
+}
+
+func newFileScope(f *ast.File, localTypes map[string]struct{}) (*fileScope, error) {
+	scope := &fileScope{
+		localTypes:   localTypes,
+		builtinNames: map[string]struct{}{},
+	}
+	for _, spec := range f.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+		if path != builtinPkgPath {
+			continue
+		}
+		switch {
+		case spec.Name == nil:
+			scope.builtinNames["builtin"] = struct{}{}
+		case spec.Name.Name == ".":
+			scope.dotBuiltin = true
+		case spec.Name.Name != "_":
+			scope.builtinNames[spec.Name.Name] = struct{}{}
+		}
+	}
+	return scope, nil
+}
+
+// encoderOf returns the encoder of a type expression. Types are resolved
+// through the imports of the file, so only predeclared types and the types
+// of pkg/builtin match; any other type is left to reflection.
+func (s *fileScope) encoderOf(expr ast.Expr) (string, bool) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if _, ok := s.localTypes[t.Name]; ok {
+			return "", false
+		}
+		if enc, ok := basicEncoders[t.Name]; ok {
+			return enc, true
+		}
+		if !s.dotBuiltin {
+			return "", false
+		}
+		enc, ok := builtinEncoders[t.Name]
+		return enc, ok
+	case *ast.SelectorExpr:
+		x, ok := t.X.(*ast.Ident)
+		if !ok {
+			return "", false
+		}
+		if _, ok := s.builtinNames[x.Name]; !ok {
+			return "", false
+		}
+		enc, ok := builtinEncoders[t.Sel.Name]
+		return enc, ok
+	}
+	return "", false
+}
+
+// Package is the view of the generated file.
+type Package struct {
+	Name    string
+	Methods []Method
+}
+
+// Method is a method a trampoline is generated for.
+type Method struct {
+	TypeName      string
+	Name          string
+	ArgEncoders   []string
+	ReturnEncoder string
+}
+
+// Generate writes the trampolines of the methods of the Go classes declared in
+// the package in dir to OutputFileName. Go classes are the types declaring a
+// GetClassName method; methods whose arguments or return value have no
+// encoder are left to reflection. A stale output file is removed when the
+// package has nothing to generate.
+func Generate(dir string) error {
+	pkg, err := ParsePackage(dir)
+	if err != nil {
+		return err
+	}
+	filename := filepath.Join(dir, OutputFileName)
+	if len(pkg.Methods) == 0 {
+		if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	src, err := Render(pkg)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, src, 0o644)
+}
+
+// ParsePackage collects the methods of the Go classes in dir.
+func ParsePackage(dir string) (Package, error) {
+	var pkg Package
+	fset := token.NewFileSet()
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return pkg, err
+	}
+	var files []*ast.File
+	localTypes := map[string]struct{}{}
+	for _, path := range paths {
+		base := filepath.Base(path)
+		if base == OutputFileName || strings.HasSuffix(base, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return pkg, err
+		}
+		if len(pkg.Name) == 0 {
+			pkg.Name = f.Name.Name
+		}
+		files = append(files, f)
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				localTypes[spec.(*ast.TypeSpec).Name.Name] = struct{}{}
+			}
+		}
+	}
+	methods := map[string][]methodDecl{}
+	for _, f := range files {
+		scope, err := newFileScope(f, localTypes)
+		if err != nil {
+			return pkg, err
+		}
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil || len(fd.Recv.List) != 1 {
+				continue
+			}
+			star, ok := fd.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			recv, ok := star.X.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			methods[recv.Name] = append(methods[recv.Name], methodDecl{fd, scope})
+		}
+	}
+	for typeName, decls := range methods {
+		if !declaresMethod(decls, "GetClassName") {
+			continue
+		}
+		for _, d := range decls {
+			if m, ok := newMethod(typeName, d); ok {
+				pkg.Methods = append(pkg.Methods, m)
+			}
+		}
+	}
+	sort.Slice(pkg.Methods, func(i, j int) bool {
+		a, b := pkg.Methods[i], pkg.Methods[j]
+		if a.TypeName != b.TypeName {
+			return a.TypeName < b.TypeName
+		}
+		return a.Name < b.Name
+	})
+	return pkg, nil
+}
+
+// methodDecl is a method declaration with the scope of its file.
+type methodDecl struct {
+	fd    *ast.FuncDecl
+	scope *fileScope
+}
+
+func declaresMethod(decls []methodDecl, name string) bool {
+	for _, d := range decls {
+		if d.fd.Name.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func newMethod(typeName string, d methodDecl) (Method, bool) {
+	fd := d.fd
+	m := Method{
+		TypeName: typeName,
+		Name:     fd.Name.Name,
+	}
+	if !fd.Name.IsExported() || fd.Type.TypeParams != nil {
+		return m, false
+	}
+	if _, ok := skippedMethods[m.Name]; ok {
+		return m, false
+	}
+	for _, field := range fd.Type.Params.List {
+		enc, ok := d.scope.encoderOf(field.Type)
+		if !ok {
+			return m, false
+		}
+		// unnamed parameters still take a slot
+		for range max(len(field.Names), 1) {
+			m.ArgEncoders = append(m.ArgEncoders, enc)
+		}
+	}
+	if fd.Type.Results != nil {
+		if fd.Type.Results.NumFields() != 1 {
+			return m, false
+		}
+		enc, ok := d.scope.encoderOf(fd.Type.Results.List[0].Type)
+		if !ok {
+			return m, false
+		}
+		m.ReturnEncoder = enc
+	}
+	return m, true
+}
+
+// Render executes the template for pkg and formats the result.
+func Render(pkg Package) ([]byte, error) {
+	tmpl, err := template.New(OutputFileName).Parse(bindingsText)
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, pkg); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("unable to format generated bindings: %w", err)
+	}
+	return src, nil
+}
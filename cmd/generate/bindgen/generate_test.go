@@ -0,0 +1,87 @@
+package bindgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testClassSource = `package demo
+
+import (
+	"example.com/mathx"
+	gd "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/builtin"
+)
+
+type Color struct{ R, G, B uint8 }
+
+type Speed float64
+
+type Player struct {
+	NodeImpl
+	speed float64
+}
+
+func (p *Player) GetClassName() string { return "Player" }
+
+func (p *Player) GetParentClassName() string { return "Node" }
+
+func (p *Player) V_Process(delta float64) {}
+
+func (p *Player) Move(dir Vector2, _ float64) Vector2 { return dir }
+
+func (p *Player) Name() gd.String { return gd.String{} }
+
+func (p *Player) Tint() Color { return Color{} }
+
+func (p *Player) Heading() mathx.Vector2 { return mathx.Vector2{} }
+
+func (p *Player) SetSpeed(speed Speed) {}
+
+func (p *Player) Load(path string) error { return nil }
+
+func (p *Player) Target() Node { return nil }
+
+func (p *Player) Sum(values ...int64) int64 { return 0 }
+
+func (p *Player) helper(delta float64) {}
+
+type helper struct{}
+
+func (h *helper) Run(n int64) {}
+`
+
+func TestParsePackage(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "player.go"), []byte(testClassSource), 0o644))
+	pkg, err := ParsePackage(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "demo", pkg.Name)
+	assert.Equal(t, []Method{
+		{TypeName: "Player", Name: "Move", ArgEncoders: []string{"Vector2Encoder", "Float64Encoder"}, ReturnEncoder: "Vector2Encoder"},
+		{TypeName: "Player", Name: "Name", ReturnEncoder: "StringEncoder"},
+		{TypeName: "Player", Name: "V_Process", ArgEncoders: []string{"Float64Encoder"}},
+	}, pkg.Methods)
+}
+
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "player.go"), []byte(testClassSource), 0o644))
+	require.NoError(t, Generate(dir))
+	src, err := os.ReadFile(filepath.Join(dir, OutputFileName))
+	require.NoError(t, err)
+	assert.Contains(t, string(src), `RegisterMethodTrampoline[*Player]("V_Process", MethodTrampoline{`)
+	assert.Contains(t, string(src), `inst.(*Player).V_Process(
+				Float64Encoder.DecodeTypePtr(args[0]),
+			)`)
+	assert.NotContains(t, string(src), `"Load"`)
+
+	// nothing left to generate removes the stale file
+	require.NoError(t, os.Remove(filepath.Join(dir, "player.go")))
+	require.NoError(t, Generate(dir))
+	assert.NoFileExists(t, filepath.Join(dir, OutputFileName))
+}
@@ -58,6 +58,7 @@ func GenerateBuiltinClasses(projectPath string, extensionApi extensionapiparser.
 			"upperFirstChar":           upperFirstChar,
 			"snakeCase":                snakeCase,
 			"goMethodName":             goMethodName,
+			"goBuiltinMethodName":      goBuiltinMethodName,
 			"goArgumentName":           goArgumentName,
 			"goArgumentType":           goArgumentType,
 			"goHasArgumentTypeEncoder": goHasArgumentTypeEncoder,
@@ -344,6 +344,21 @@ func goMethodName(n string) string {
 	return strcase.ToCamel(n)
 }
 
+// builtinMethodNames renames builtin class methods whose Go name is taken by
+// a hand written method.
+var builtinMethodNames = map[string]map[string]string{
+	// Array.All is the iterator in iter.go
+	"Array": {"all": "AllMatch"},
+}
+
+func goBuiltinMethodName(className string, n string) string {
+	if name, ok := builtinMethodNames[className][n]; ok {
+		return name
+	}
+
+	return goMethodName(n)
+}
+
 func nativeStructureFormatToFields(f string) string {
 	sb := strings.Builder{}
 	fields := strings.Split(f, ";")
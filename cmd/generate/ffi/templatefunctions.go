@@ -367,6 +367,7 @@ func loadProcAddressName(typeName string) string {
 	ret = strings.Replace(ret, "_2", "2", 1)
 	ret = strings.Replace(ret, "_3", "3", 1)
 	ret = strings.Replace(ret, "_4", "4", 1)
+	ret = strings.Replace(ret, "_5", "5", 1)
 	ret = strings.Replace(ret, "place_holder", "placeholder", 1)
 	return ret
 }
@@ -36,19 +36,22 @@ func Generate(projectPath string, eapi extensionapiparser.ExtensionApi) {
 func GenerateClasses(projectPath string, extensionApi extensionapiparser.ExtensionApi) error {
 	tmpl, err := template.New("classes.gen.go").
 		Funcs(template.FuncMap{
-			"isSetterMethodName":   isSetterMethodName,
-			"goVariantConstructor": goVariantConstructor,
-			"goMethodName":         goMethodName,
-			"goArgumentName":       goArgumentName,
-			"goArgumentType":       goArgumentType,
-			"goVariantFunc":        goVariantFunc,
-			"goReturnType":         goReturnType,
-			"goClassEnumName":      goClassEnumName,
-			"goClassStructName":    goClassStructName,
-			"goClassInterfaceName": goClassInterfaceName,
-			"goEncoder":            goEncoder,
-			"goEncodeIsReference":  goEncodeIsReference,
-			"coalesce":             coalesce,
+			"isSetterMethodName":     isSetterMethodName,
+			"goVariantConstructor":   goVariantConstructor,
+			"goMethodName":           goMethodName,
+			"goArgumentName":         goArgumentName,
+			"goArgumentType":         goArgumentType,
+			"goVariantFunc":          goVariantFunc,
+			"goReturnType":           goReturnType,
+			"goClassEnumName":        goClassEnumName,
+			"goClassStructName":      goClassStructName,
+			"goClassInterfaceName":   goClassInterfaceName,
+			"goVirtualMethodName":    goVirtualMethodName,
+			"goVirtualInterfaceName": goVirtualInterfaceName,
+			"goVirtualArgumentType":  goVirtualArgumentType,
+			"goEncoder":              goEncoder,
+			"goEncodeIsReference":    goEncodeIsReference,
+			"coalesce":               coalesce,
 		}).
 		Parse(classesText)
 
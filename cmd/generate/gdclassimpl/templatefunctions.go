@@ -367,6 +367,38 @@ func goMethodName(n string) string {
 	return strcase.ToCamel(n)
 }
 
+func goVirtualMethodName(n string) string {
+	return fmt.Sprintf("V_%s", strcase.ToCamel(n))
+}
+
+func goVirtualInterfaceName(c, n string) string {
+	return fmt.Sprintf("%s%sVirtual", c, strcase.ToCamel(n))
+}
+
+// goVirtualArgumentType is goArgumentType for the signatures of the virtual
+// method interfaces: strings are Go strings like in bound methods, typed
+// arrays are Arrays and native structures are passed as unsafe.Pointer.
+func goVirtualArgumentType(t string, nativeStructures []extensionapiparser.NativeStructure) string {
+	switch {
+	case strings.HasPrefix(t, "typedarray::"):
+		return "Array"
+	case strings.HasPrefix(t, "typeddictionary::"):
+		return "Dictionary"
+	case t == "String":
+		return "string"
+	}
+
+	n := strings.TrimSpace(strings.TrimRight(strings.TrimPrefix(t, "const "), "*"))
+
+	for _, s := range nativeStructures {
+		if s.Name == n {
+			return "unsafe.Pointer"
+		}
+	}
+
+	return goArgumentType(t)
+}
+
 func nativeStructureFormatToFields(f string) string {
 	sb := strings.Builder{}
 	fields := strings.Split(f, ";")
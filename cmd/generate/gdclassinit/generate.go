@@ -42,15 +42,16 @@ func Generate(projectPath string, eapi extensionapiparser.ExtensionApi) {
 func GenerateClassInit(projectPath string, extensionApi extensionapiparser.ExtensionApi) error {
 	tmpl, err := template.New("classes.init.gen.go").
 		Funcs(template.FuncMap{
-			"goVariantConstructor": goVariantConstructor,
-			"goMethodName":         goMethodName,
-			"goArgumentName":       goArgumentName,
-			"goArgumentType":       goArgumentType,
-			"goReturnType":         goReturnType,
-			"goClassEnumName":      goClassEnumName,
-			"goClassStructName":    goClassStructName,
-			"goClassInterfaceName": goClassInterfaceName,
-			"coalesce":             coalesce,
+			"goVariantConstructor":   goVariantConstructor,
+			"goMethodName":           goMethodName,
+			"goArgumentName":         goArgumentName,
+			"goArgumentType":         goArgumentType,
+			"goReturnType":           goReturnType,
+			"goClassEnumName":        goClassEnumName,
+			"goClassStructName":      goClassStructName,
+			"goClassInterfaceName":   goClassInterfaceName,
+			"goVirtualInterfaceName": goVirtualInterfaceName,
+			"coalesce":               coalesce,
 		}).
 		Parse(classesInitText)
 
@@ -372,6 +372,10 @@ func goMethodName(n string) string {
 	return strcase.ToCamel(n)
 }
 
+func goVirtualInterfaceName(c, n string) string {
+	return fmt.Sprintf("%s%sVirtual", c, strcase.ToCamel(n))
+}
+
 func nativeStructureFormatToFields(f string) string {
 	sb := strings.Builder{}
 	fields := strings.Split(f, ";")
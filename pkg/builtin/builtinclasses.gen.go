@@ -22749,11 +22749,11 @@ func (cx *Array) Any(method Callable) bool {
 	return ret
 }
 
-/* All : all
+/* AllMatch : all
  * is_vararg = false, is_static = false
  * goReturnType(bool) -> bool
  */
-func (cx *Array) All(method Callable) bool {
+func (cx *Array) AllMatch(method Callable) bool {
 	mb := globalArrayMethodBindings.method_all
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
@@ -0,0 +1,339 @@
+package builtin
+
+// #include <godot/gdextension_interface.h>
+// #include "callable_custom.h"
+import "C"
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"runtime/cgo"
+	"sync/atomic"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// callableEncoder converts a single Go argument or return value of a func
+// wrapped by NewCallableFromFunc.
+type callableEncoder struct {
+	// variantType is the type of Variant the encoder decodes; NIL accepts
+	// any Variant.
+	variantType GDExtensionVariantType
+	// goType is the Go type the encoder produces; values are converted to
+	// and from named types with the same underlying type.
+	goType  reflect.Type
+	encoder ArgumentEncoder
+}
+
+var callableObjectType = reflect.TypeFor[Object]()
+
+// callableEncoderFor returns the encoder of t. Named types fall back to the
+// encoder of their underlying basic type; interfaces of engine objects are
+// decoded through Variant.ToObject.
+func callableEncoderFor(t reflect.Type) (callableEncoder, bool) {
+	if enc, vt, ok := EncoderForType(t); ok {
+		return callableEncoder{variantType: vt, goType: t, encoder: enc}, true
+	}
+	if t.Kind() == reflect.Interface && (t == callableObjectType || t.Implements(callableObjectType)) {
+		return callableEncoder{
+			variantType: GDEXTENSION_VARIANT_TYPE_OBJECT,
+			goType:      t,
+			encoder:     ObjectEncoder,
+		}, true
+	}
+	for bt, enc := range typeEncoders {
+		if bt.Kind() == t.Kind() && bt.PkgPath() == "" && bt.Name() == t.Kind().String() {
+			return callableEncoder{variantType: enc.variantType, goType: bt, encoder: enc.encoder}, true
+		}
+	}
+	return callableEncoder{}, false
+}
+
+func (e callableEncoder) decode(t reflect.Type, ptr GDExtensionConstVariantPtr) (reflect.Value, bool) {
+	v := (*Variant)(unsafe.Pointer(ptr))
+	vt := v.GetType()
+	switch {
+	case e.variantType == GDEXTENSION_VARIANT_TYPE_NIL:
+		return e.encoder.DecodeReflectVariantPtr(ptr), true
+	case e.variantType == GDEXTENSION_VARIANT_TYPE_OBJECT:
+		if vt == GDEXTENSION_VARIANT_TYPE_NIL {
+			return reflect.Zero(t), true
+		}
+		if vt != GDEXTENSION_VARIANT_TYPE_OBJECT {
+			return reflect.Value{}, false
+		}
+		obj := v.ToObject()
+		if obj == nil || !reflect.TypeOf(obj).AssignableTo(t) {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(obj).Convert(t), true
+	case vt == e.variantType:
+		return e.encoder.DecodeReflectVariantPtr(ptr).Convert(t), true
+	case vt == GDEXTENSION_VARIANT_TYPE_INT && e.variantType == GDEXTENSION_VARIANT_TYPE_FLOAT:
+		// GDScript passes integer literals to float parameters
+		return Int64Encoder.DecodeReflectVariantPtr(ptr).Convert(t), true
+	default:
+		return reflect.Value{}, false
+	}
+}
+
+func (e callableEncoder) encode(rv reflect.Value, rOut GDExtensionUninitializedVariantPtr) {
+	if e.variantType == GDEXTENSION_VARIANT_TYPE_OBJECT {
+		if rv.IsNil() {
+			GDExtensionVariantPtrWithNil(rOut)
+			return
+		}
+		ObjectEncoder.EncodeVariantPtrArg(rv.Interface().(Object), rOut)
+		return
+	}
+	e.encoder.EncodeReflectVariantPtrArg(rv.Convert(e.goType), rOut)
+}
+
+// goCallable is the userdata of a Callable created by NewCallableFromFunc.
+// Every Callable created from the same goCallable compares equal.
+type goCallable struct {
+	fn       reflect.Value
+	name     string
+	args     []callableEncoder
+	variadic bool
+	ret      *callableEncoder
+	// handles counts the Callables Godot has not freed yet; onFree runs once
+	// the last one is freed.
+	handles atomic.Int32
+	onFree  func()
+}
+
+func (c *goCallable) call(argPtrs []GDExtensionConstVariantPtr, rReturn GDExtensionVariantPtr, rError *GDExtensionCallError) {
+	fnType := c.fn.Type()
+	fixed := len(c.args)
+	if c.variadic {
+		fixed--
+	}
+	// the engine does not initialize the call error it passes in
+	rError.SetErrorFields(GDEXTENSION_CALL_OK, 0, 0)
+	switch {
+	case len(argPtrs) < fixed:
+		rError.SetErrorFields(GDEXTENSION_CALL_ERROR_TOO_FEW_ARGUMENTS, 0, int32(fixed))
+		return
+	case !c.variadic && len(argPtrs) > fixed:
+		rError.SetErrorFields(GDEXTENSION_CALL_ERROR_TOO_MANY_ARGUMENTS, 0, int32(fixed))
+		return
+	}
+	args := make([]reflect.Value, 0, len(argPtrs))
+	// decoded arguments are copies owned by the call
+	defer func() {
+		for _, a := range args {
+			destroyCallableArg(a)
+		}
+	}()
+	for i, ptr := range argPtrs {
+		enc := c.args[min(i, len(c.args)-1)]
+		var t reflect.Type
+		if i < fixed {
+			t = fnType.In(i)
+		} else {
+			t = fnType.In(fixed).Elem()
+		}
+		v, ok := enc.decode(t, ptr)
+		if !ok {
+			rError.SetErrorFields(GDEXTENSION_CALL_ERROR_INVALID_ARGUMENT, int32(i), int32(enc.variantType))
+			return
+		}
+		args = append(args, v)
+	}
+	ret := c.fn.Call(args)
+	if c.ret != nil {
+		c.ret.encode(ret[0], (GDExtensionUninitializedVariantPtr)(rReturn))
+	}
+}
+
+var callableDestroyerType = reflect.TypeFor[interface{ Destroy() }]()
+
+// destroyCallableArg destroys a decoded argument that holds engine memory,
+// like String, Array or Variant.
+func destroyCallableArg(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Array, reflect.Struct:
+	default:
+		return
+	}
+	if !reflect.PointerTo(v.Type()).Implements(callableDestroyerType) {
+		return
+	}
+	p := reflect.New(v.Type())
+	p.Elem().Set(v)
+	p.Interface().(interface{ Destroy() }).Destroy()
+}
+
+// NewCallableFromFunc wraps fn in a Callable that can be connected to
+// signals, deferred or passed to engine APIs like Array.sort_custom.
+// Parameters and the optional single return value of fn may be any type
+// with an ArgumentEncoder, Object interfaces or Variant; a variadic
+// ...Variant parameter receives the remaining arguments. Arguments are
+// destroyed once fn returns; fn has to copy any it keeps.
+func NewCallableFromFunc(fn any) Callable {
+	return newGoCallable(fn).newCallable()
+}
+
+func newGoCallable(fn any) *goCallable {
+	rv := reflect.ValueOf(fn)
+	if rv.Kind() != reflect.Func || rv.IsNil() {
+		log.Panic("NewCallableFromFunc requires a non-nil func",
+			zap.String("type", fmt.Sprintf("%T", fn)),
+		)
+	}
+	t := rv.Type()
+	c := &goCallable{
+		fn:       rv,
+		name:     t.String(),
+		args:     make([]callableEncoder, t.NumIn()),
+		variadic: t.IsVariadic(),
+	}
+	if f := runtime.FuncForPC(rv.Pointer()); f != nil {
+		c.name = f.Name()
+	}
+	for i := range t.NumIn() {
+		at := t.In(i)
+		if c.variadic && i == t.NumIn()-1 {
+			at = at.Elem()
+		}
+		enc, ok := callableEncoderFor(at)
+		if !ok {
+			log.Panic("unsupported callable argument type",
+				zap.String("func", c.name),
+				zap.Int("index", i),
+				zap.String("type", at.String()),
+			)
+		}
+		c.args[i] = enc
+	}
+	switch t.NumOut() {
+	case 0:
+	case 1:
+		enc, ok := callableEncoderFor(t.Out(0))
+		if !ok {
+			log.Panic("unsupported callable return type",
+				zap.String("func", c.name),
+				zap.String("type", t.Out(0).String()),
+			)
+		}
+		c.ret = &enc
+	default:
+		log.Panic("callable funcs may return at most one value",
+			zap.String("func", c.name),
+		)
+	}
+	return c
+}
+
+func (c *goCallable) newCallable() Callable {
+	c.handles.Add(1)
+	handle := cgo.NewHandle(c)
+	info := NewGDExtensionCallableCustomInfo2(
+		unsafe.Pointer(handle),
+		unsafe.Pointer(FFI.Library),
+		0,
+		(GDExtensionCallableCustomCall)(C.cgo_callable_custom_call),
+		nil,
+		(GDExtensionCallableCustomFree)(C.cgo_callable_custom_free),
+		(GDExtensionCallableCustomHash)(C.cgo_callable_custom_hash),
+		(GDExtensionCallableCustomEqual)(C.cgo_callable_custom_equal),
+		(GDExtensionCallableCustomLessThan)(C.cgo_callable_custom_less_than),
+		(GDExtensionCallableCustomToString)(C.cgo_callable_custom_to_string),
+		(GDExtensionCallableCustomGetArgumentCount)(C.cgo_callable_custom_get_argument_count),
+	)
+	cx := Callable{}
+	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
+	pnr.Pin(ptr)
+	CallFunc_GDExtensionInterfaceCallableCustomCreate2(ptr, &info)
+	return cx
+}
+
+// GoFunc returns the func wrapped by NewCallableFromFunc; ok is false for any
+// other Callable.
+func (cx *Callable) GoFunc() (fn any, ok bool) {
+	ud := CallFunc_GDExtensionInterfaceCallableCustomGetUserData(cx.NativeConstPtr(), unsafe.Pointer(FFI.Library))
+	if ud == nil {
+		return nil, false
+	}
+	return goCallableFromUserData(ud).fn.Interface(), true
+}
+
+func goCallableFromUserData(ud unsafe.Pointer) *goCallable {
+	c, ok := cgo.Handle(ud).Value().(*goCallable)
+	if !ok || c == nil {
+		log.Panic("unable to retrieve callable userdata")
+	}
+	return c
+}
+
+//export GoCallback_CallableCustomCall
+func GoCallback_CallableCustomCall(
+	callableUserData unsafe.Pointer,
+	argPtrs *C.GDExtensionConstVariantPtr,
+	argumentCount C.GDExtensionInt,
+	rReturn C.GDExtensionVariantPtr,
+	rError *C.GDExtensionCallError,
+) {
+	c := goCallableFromUserData(callableUserData)
+	log.Debug("GoCallback_CallableCustomCall called",
+		zap.String("func", c.name),
+		zap.Int("argc", int(argumentCount)),
+	)
+	argPtrSlice := unsafe.Slice((*GDExtensionConstVariantPtr)(argPtrs), int(argumentCount))
+	c.call(argPtrSlice, (GDExtensionVariantPtr)(rReturn), (*GDExtensionCallError)(unsafe.Pointer(rError)))
+}
+
+//export GoCallback_CallableCustomFree
+func GoCallback_CallableCustomFree(callableUserData unsafe.Pointer) {
+	c := goCallableFromUserData(callableUserData)
+	cgo.Handle(callableUserData).Delete()
+	if c.handles.Add(-1) == 0 && c.onFree != nil {
+		c.onFree()
+	}
+}
+
+//export GoCallback_CallableCustomHash
+func GoCallback_CallableCustomHash(callableUserData unsafe.Pointer) C.uint32_t {
+	h := uint64(uintptr(unsafe.Pointer(goCallableFromUserData(callableUserData))))
+	return (C.uint32_t)(uint32(h ^ h>>32))
+}
+
+//export GoCallback_CallableCustomEqual
+func GoCallback_CallableCustomEqual(a unsafe.Pointer, b unsafe.Pointer) C.GDExtensionBool {
+	if goCallableFromUserData(a) == goCallableFromUserData(b) {
+		return 1
+	}
+	return 0
+}
+
+//export GoCallback_CallableCustomLessThan
+func GoCallback_CallableCustomLessThan(a unsafe.Pointer, b unsafe.Pointer) C.GDExtensionBool {
+	ca, cb := goCallableFromUserData(a), goCallableFromUserData(b)
+	if uintptr(unsafe.Pointer(ca)) < uintptr(unsafe.Pointer(cb)) {
+		return 1
+	}
+	return 0
+}
+
+//export GoCallback_CallableCustomToString
+func GoCallback_CallableCustomToString(callableUserData unsafe.Pointer, rIsValid *C.GDExtensionBool, rOut C.GDExtensionStringPtr) {
+	c := goCallableFromUserData(callableUserData)
+	GDExtensionStringPtrWithUtf8Chars((GDExtensionStringPtr)(rOut), fmt.Sprintf("GoCallable(%s)", c.name))
+	*rIsValid = 1
+}
+
+//export GoCallback_CallableCustomGetArgumentCount
+func GoCallback_CallableCustomGetArgumentCount(callableUserData unsafe.Pointer, rIsValid *C.GDExtensionBool) C.GDExtensionInt {
+	c := goCallableFromUserData(callableUserData)
+	if c.variadic {
+		// variadic funcs accept any number of trailing arguments
+		*rIsValid = 0
+		return (C.GDExtensionInt)(len(c.args) - 1)
+	}
+	*rIsValid = 1
+	return (C.GDExtensionInt)(len(c.args))
+}
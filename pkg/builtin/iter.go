@@ -0,0 +1,303 @@
+package builtin
+
+import (
+	"iter"
+	"reflect"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// The iterators below yield temporary Variants and Strings that are
+// destroyed once the loop body returns, even when it panics; copy a value to
+// keep it. The size of a container is read again on every step so the loop
+// body may shrink it.
+
+// All returns an iterator over the indexes and elements of the array. The
+// all method of Godot is bound as AllMatch.
+func (cx *Array) All() iter.Seq2[int64, Variant] {
+	return func(yield func(int64, Variant) bool) {
+		for i := int64(0); i < cx.Size(); i++ {
+			v := cx.GetIndexed(i)
+			if !yieldDestroyed(yield, i, v, v.Destroy) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the array.
+func (cx *Array) Values() iter.Seq[Variant] {
+	return seqValues(cx.All())
+}
+
+// All returns an iterator over the keys and values of the dictionary. The
+// keys are read before the first step, so the loop body may add or erase
+// entries; erased keys are skipped.
+func (cx *Dictionary) All() iter.Seq2[Variant, Variant] {
+	return func(yield func(Variant, Variant) bool) {
+		keys := cx.Keys()
+		defer keys.Destroy()
+		missing := NewVariantNil()
+		defer missing.Destroy()
+		for i := int64(0); i < keys.Size(); i++ {
+			k := keys.GetIndexed(i)
+			if !cx.Has(k) {
+				k.Destroy()
+				continue
+			}
+			v := cx.Get(k, missing)
+			if !yieldDestroyed(yield, k, v, func() {
+				k.Destroy()
+				v.Destroy()
+			}) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the indexes and elements of the array,
+// converted like Get.
+func (cx *TypedArray[T]) All() iter.Seq2[int64, T] {
+	return func(yield func(int64, T) bool) {
+		for i := int64(0); i < cx.Size(); i++ {
+			v := cx.Get(i)
+			if !yieldDestroyed(yield, i, v, destroyerOf(&v)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the array, converted like
+// Get.
+func (cx *TypedArray[T]) Values() iter.Seq[T] {
+	return seqValues(cx.All())
+}
+
+// All returns an iterator over the keys and values of the dictionary,
+// converted like Get.
+func (cx *TypedDictionary[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		key := typedElementFor(reflect.TypeFor[K]())
+		value := typedElementFor(reflect.TypeFor[V]())
+		for k, v := range cx.Dictionary.All() {
+			tk := typedValue[K](key.fromVariant(&k))
+			tv := typedValue[V](value.fromVariant(&v))
+			if !yieldDestroyed(yield, tk, tv, func() {
+				destroyerOf(&tk)()
+				destroyerOf(&tv)()
+			}) {
+				return
+			}
+		}
+	}
+}
+
+// Iter returns an iterator over anything Godot can iterate with a for loop:
+// ranges of numbers and vectors, strings, containers and objects
+// implementing _iter_init, _iter_next and _iter_get. It panics when the
+// Variant cannot be iterated.
+func (c *Variant) Iter() iter.Seq[Variant] {
+	return func(yield func(Variant) bool) {
+		var (
+			state Variant
+			valid GDExtensionBool
+		)
+		more := CallFunc_GDExtensionInterfaceVariantIterInit(
+			c.NativeConstPtr(), (GDExtensionUninitializedVariantPtr)(state.NativePtr()), &valid)
+		defer state.Destroy()
+		for valid != 0 && more != 0 {
+			var v Variant
+			CallFunc_GDExtensionInterfaceVariantIterGet(
+				c.NativeConstPtr(), state.NativePtr(), (GDExtensionUninitializedVariantPtr)(v.NativePtr()), &valid)
+			if valid == 0 {
+				break
+			}
+			if !yieldDestroyed(yieldValue(yield), 0, v, v.Destroy) {
+				return
+			}
+			more = CallFunc_GDExtensionInterfaceVariantIterNext(c.NativeConstPtr(), state.NativePtr(), &valid)
+		}
+		if valid == 0 {
+			log.Panic("unable to iterate variant",
+				zap.String("type", GDExtensionVariantTypeStringMap[c.GetType()]),
+				zap.Error(ErrInvalid),
+			)
+		}
+	}
+}
+
+// yieldDestroyed passes k and v to yield and calls destroy once it returns.
+func yieldDestroyed[K, V any](yield func(K, V) bool, k K, v V, destroy func()) bool {
+	defer destroy()
+	return yield(k, v)
+}
+
+// destroyerOf returns the Destroy method of the value v points to when it
+// holds engine memory, like a String or Variant, and a no-op otherwise.
+func destroyerOf[T any](v *T) func() {
+	if d, ok := any(v).(interface{ Destroy() }); ok {
+		return d.Destroy
+	}
+	return func() {}
+}
+
+// yieldValue adapts the yield func of an iter.Seq to yieldDestroyed.
+func yieldValue[V any](yield func(V) bool) func(int, V) bool {
+	return func(_ int, v V) bool {
+		return yield(v)
+	}
+}
+
+// seqValues drops the indexes of an iterator.
+func seqValues[K, V any](seq iter.Seq2[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range seq {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// packedAll iterates a packed array through at, which reads an element in
+// place, so values keep their exact Go type.
+func packedAll[T any](size func() int64, at func(i int64) T) iter.Seq2[int64, T] {
+	return func(yield func(int64, T) bool) {
+		for i := int64(0); i < size(); i++ {
+			if !yield(i, at(i)) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the indexes and bytes of the array.
+func (cx *PackedByteArray) All() iter.Seq2[int64, byte] {
+	return packedAll(cx.Size, func(i int64) byte {
+		return *CallFunc_GDExtensionInterfacePackedByteArrayOperatorIndexConst(cx.NativeConstPtr(), GDExtensionInt(i))
+	})
+}
+
+// Values returns an iterator over the bytes of the array.
+func (cx *PackedByteArray) Values() iter.Seq[byte] {
+	return seqValues(cx.All())
+}
+
+// All returns an iterator over the indexes and elements of the array.
+func (cx *PackedInt32Array) All() iter.Seq2[int64, int32] {
+	return packedAll(cx.Size, func(i int64) int32 {
+		return *CallFunc_GDExtensionInterfacePackedInt32ArrayOperatorIndexConst(cx.NativeConstPtr(), GDExtensionInt(i))
+	})
+}
+
+// Values returns an iterator over the elements of the array.
+func (cx *PackedInt32Array) Values() iter.Seq[int32] {
+	return seqValues(cx.All())
+}
+
+// All returns an iterator over the indexes and elements of the array.
+func (cx *PackedInt64Array) All() iter.Seq2[int64, int64] {
+	return packedAll(cx.Size, func(i int64) int64 {
+		return *CallFunc_GDExtensionInterfacePackedInt64ArrayOperatorIndexConst(cx.NativeConstPtr(), GDExtensionInt(i))
+	})
+}
+
+// Values returns an iterator over the elements of the array.
+func (cx *PackedInt64Array) Values() iter.Seq[int64] {
+	return seqValues(cx.All())
+}
+
+// All returns an iterator over the indexes and elements of the array.
+func (cx *PackedFloat32Array) All() iter.Seq2[int64, float32] {
+	return packedAll(cx.Size, func(i int64) float32 {
+		return *CallFunc_GDExtensionInterfacePackedFloat32ArrayOperatorIndexConst(cx.NativeConstPtr(), GDExtensionInt(i))
+	})
+}
+
+// Values returns an iterator over the elements of the array.
+func (cx *PackedFloat32Array) Values() iter.Seq[float32] {
+	return seqValues(cx.All())
+}
+
+// All returns an iterator over the indexes and elements of the array.
+func (cx *PackedFloat64Array) All() iter.Seq2[int64, float64] {
+	return packedAll(cx.Size, func(i int64) float64 {
+		return *CallFunc_GDExtensionInterfacePackedFloat64ArrayOperatorIndexConst(cx.NativeConstPtr(), GDExtensionInt(i))
+	})
+}
+
+// Values returns an iterator over the elements of the array.
+func (cx *PackedFloat64Array) Values() iter.Seq[float64] {
+	return seqValues(cx.All())
+}
+
+// All returns an iterator over the indexes and strings of the array; each
+// String is a copy destroyed once the loop body returns.
+func (cx *PackedStringArray) All() iter.Seq2[int64, String] {
+	return func(yield func(int64, String) bool) {
+		for i := int64(0); i < cx.Size(); i++ {
+			s := cx.GetIndexed(i)
+			if !yieldDestroyed(yield, i, s, s.Destroy) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the strings of the array.
+func (cx *PackedStringArray) Values() iter.Seq[String] {
+	return seqValues(cx.All())
+}
+
+// All returns an iterator over the indexes and elements of the array.
+func (cx *PackedVector2Array) All() iter.Seq2[int64, Vector2] {
+	return packedAll(cx.Size, func(i int64) Vector2 {
+		return *(*Vector2)(unsafe.Pointer(CallFunc_GDExtensionInterfacePackedVector2ArrayOperatorIndexConst(cx.NativeConstPtr(), GDExtensionInt(i))))
+	})
+}
+
+// Values returns an iterator over the elements of the array.
+func (cx *PackedVector2Array) Values() iter.Seq[Vector2] {
+	return seqValues(cx.All())
+}
+
+// All returns an iterator over the indexes and elements of the array.
+func (cx *PackedVector3Array) All() iter.Seq2[int64, Vector3] {
+	return packedAll(cx.Size, func(i int64) Vector3 {
+		return *(*Vector3)(unsafe.Pointer(CallFunc_GDExtensionInterfacePackedVector3ArrayOperatorIndexConst(cx.NativeConstPtr(), GDExtensionInt(i))))
+	})
+}
+
+// Values returns an iterator over the elements of the array.
+func (cx *PackedVector3Array) Values() iter.Seq[Vector3] {
+	return seqValues(cx.All())
+}
+
+// All returns an iterator over the indexes and elements of the array.
+func (cx *PackedColorArray) All() iter.Seq2[int64, Color] {
+	return packedAll(cx.Size, func(i int64) Color {
+		return *(*Color)(unsafe.Pointer(CallFunc_GDExtensionInterfacePackedColorArrayOperatorIndexConst(cx.NativeConstPtr(), GDExtensionInt(i))))
+	})
+}
+
+// Values returns an iterator over the elements of the array.
+func (cx *PackedColorArray) Values() iter.Seq[Color] {
+	return seqValues(cx.All())
+}
+
+// All returns an iterator over the indexes and elements of the array.
+func (cx *PackedVector4Array) All() iter.Seq2[int64, Vector4] {
+	return packedAll(cx.Size, func(i int64) Vector4 {
+		return *(*Vector4)(unsafe.Pointer(CallFunc_GDExtensionInterfacePackedVector4ArrayOperatorIndexConst(cx.NativeConstPtr(), GDExtensionInt(i))))
+	})
+}
+
+// Values returns an iterator over the elements of the array.
+func (cx *PackedVector4Array) Values() iter.Seq[Vector4] {
+	return seqValues(cx.All())
+}
@@ -0,0 +1,15 @@
+#include "main_thread.h"
+
+#ifdef _WIN32
+#include <windows.h>
+
+uint64_t cgo_current_thread_id() {
+	return (uint64_t)GetCurrentThreadId();
+}
+#else
+#include <pthread.h>
+
+uint64_t cgo_current_thread_id() {
+	return (uint64_t)(uintptr_t)pthread_self();
+}
+#endif
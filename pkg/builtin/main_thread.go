@@ -0,0 +1,52 @@
+package builtin
+
+// #include "main_thread.h"
+import "C"
+import (
+	"sync/atomic"
+
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+var (
+	mainThreadID    atomic.Uint64
+	mainThreadCheck atomic.Bool
+)
+
+// CurrentThreadID returns the ID of the OS thread running the caller.
+func CurrentThreadID() uint64 {
+	return uint64(C.cgo_current_thread_id())
+}
+
+// RecordMainThread marks the OS thread running the caller as the engine's
+// main thread. It is called while the extension initializes.
+func RecordMainThread() {
+	mainThreadID.Store(CurrentThreadID())
+}
+
+// IsMainThread returns true when the caller runs on the engine's main thread.
+// Goroutines only run there while the engine is calling into Go.
+func IsMainThread() bool {
+	return CurrentThreadID() == mainThreadID.Load()
+}
+
+// SetMainThreadCheck enables or disables logging engine calls made from
+// threads other than the main thread. It is meant for debugging.
+func SetMainThreadCheck(enabled bool) {
+	mainThreadCheck.Store(enabled)
+}
+
+// CheckMainThread logs an error, which includes the stack trace, if the main
+// thread check is enabled and method is called from a thread other than the
+// main thread.
+func CheckMainThread(method string) {
+	if !mainThreadCheck.Load() || IsMainThread() {
+		return
+	}
+	log.Error("engine method called off the main thread",
+		zap.String("method", method),
+		zap.Uint64("thread_id", CurrentThreadID()),
+		zap.Uint64("main_thread_id", mainThreadID.Load()),
+	)
+}
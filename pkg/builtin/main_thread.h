@@ -0,0 +1,8 @@
+#ifndef CGO_GODOT_GO_MAIN_THREAD_H
+#define CGO_GODOT_GO_MAIN_THREAD_H
+
+#include <stdint.h>
+
+uint64_t cgo_current_thread_id();
+
+#endif
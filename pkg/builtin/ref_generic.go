@@ -1,5 +1,9 @@
 package builtin
 
+import (
+	. "github.com/godot-go/godot-go/pkg/ffi"
+)
+
 type Ref interface {
 	Ptr() RefCounted
 	Unref()
@@ -54,12 +58,13 @@ func (cx *TypedRef[T]) RefPointer(r T) {
 	cx.Reference = r
 }
 
+// Unref decrements the reference counter and destroys the object once the
+// last reference is gone; instances of Go classes are released by the engine
+// through their free instance callback.
 func (cx *TypedRef[T]) Unref() {
 	var zero T
-	if cx.Reference != zero && cx.Reference.Unreference() {
-		// cx.Reference.Destroy()
-		// release memory
-		// runtime.Unpin(cx.reference)
+	if cx.Reference != nil && cx.Reference != zero && cx.Reference.Unreference() {
+		CallFunc_GDExtensionInterfaceObjectDestroy(cx.Reference.AsGDExtensionObjectPtr())
 	}
 	cx.Reference = zero
 }
@@ -0,0 +1,121 @@
+package builtin
+
+import (
+	"context"
+	"sync"
+
+	. "github.com/godot-go/godot-go/pkg/constant"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// Await connects a one-shot handler to signal on obj and returns a channel
+// that receives the arguments of the next emission. The channel is closed
+// after the emission, or without a value when the connection fails or obj is
+// freed first. The received Variants are copies owned by the receiver.
+//
+// Await connects right away, so like other engine calls it has to be called
+// on the main thread; the channel can then be received from a goroutine:
+//
+//	ch := Await(timer, "timeout")
+//	go func() {
+//		<-ch
+//		RunOnMain(RunNextCutsceneStep)
+//	}()
+func Await(obj Object, signal string) <-chan []Variant {
+	return AwaitContext(context.Background(), obj, signal)
+}
+
+// AwaitContext is like Await, and also has to be called on the main thread,
+// but also closes the channel without a value when
+// ctx is done before signal is emitted. The handler is then disconnected on
+// the main thread at the end of the frame.
+func AwaitContext(ctx context.Context, obj Object, signal string) <-chan []Variant {
+	var (
+		ch   = make(chan []Variant, 1)
+		done = make(chan struct{})
+		once sync.Once
+	)
+	// the handler runs on the thread that emits the signal; the buffered
+	// channel keeps it from blocking on the receiver
+	finish := func(args []Variant, emitted bool) {
+		once.Do(func() {
+			if emitted {
+				ch <- args
+			}
+			close(ch)
+			close(done)
+		})
+	}
+	c := newGoCallable(func(args ...Variant) {
+		// the arguments are destroyed once the handler returns
+		copies := make([]Variant, len(args))
+		for i := range args {
+			CallFunc_GDExtensionInterfaceVariantNewCopy((GDExtensionUninitializedVariantPtr)(copies[i].NativePtr()), args[i].NativeConstPtr())
+		}
+		finish(copies, true)
+	})
+	c.onFree = func() {
+		finish(nil, false)
+	}
+	sn := NewStringNameWithUtf8Chars(signal)
+	defer sn.Destroy()
+	callable := c.newCallable()
+	// Godot keeps its own reference to the connected Callable, which is
+	// freed after the one-shot emission or together with obj
+	defer callable.Destroy()
+	if err := obj.Connect(sn, callable, uint32(OBJECT_CONNECT_FLAGS_CONNECT_ONE_SHOT)); err != OK {
+		log.Warn("unable to connect to signal",
+			zap.String("signal", signal),
+			zap.Int("error", int(err)),
+		)
+		finish(nil, false)
+		return ch
+	}
+	if ctx.Done() == nil {
+		return ch
+	}
+	id := CallFunc_GDExtensionInterfaceObjectGetInstanceId((GDExtensionConstObjectPtr)(obj.GetGodotObjectOwner()))
+	go func() {
+		select {
+		case <-done:
+		case <-ctx.Done():
+			finish(nil, false)
+			// obj can be freed by the main thread at any time, so it is only
+			// checked and disconnected from there
+			disconnect := NewCallableFromFunc(func() {
+				if CallFunc_GDExtensionInterfaceObjectGetInstanceFromId(id) == nil {
+					return
+				}
+				// a new Callable of the same func compares equal to the
+				// connected one
+				sn := NewStringNameWithUtf8Chars(signal)
+				defer sn.Destroy()
+				key := c.newCallable()
+				defer key.Destroy()
+				if obj.IsConnected(sn, key) {
+					obj.Disconnect(sn, key)
+				}
+			})
+			defer disconnect.Destroy()
+			callDeferred(disconnect)
+		}
+	}()
+	return ch
+}
+
+// callDeferred queues c to be called on the main thread at the end of the
+// frame. Unlike most engine calls, it is safe from any thread.
+func callDeferred(c Callable) {
+	v := NewVariantCallable(c)
+	defer v.Destroy()
+	ret, err := v.Call("call_deferred", nil)
+	if err != nil {
+		log.Warn("unable to defer callable",
+			zap.Error(err),
+		)
+		return
+	}
+	ret.Destroy()
+}
@@ -0,0 +1,396 @@
+package builtin
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// TypedArray is an Array whose elements are all of type T. The element type
+// is set on the engine side, including the class name of object types, so
+// GDScript sees the array as Array[T]; TypedArray[Variant] is untyped. The
+// methods of Array are promoted except Get, Set and Append, which take and
+// return T.
+type TypedArray[T any] struct {
+	Array
+}
+
+// NewTypedArray returns a new empty TypedArray.
+func NewTypedArray[T any]() TypedArray[T] {
+	arr := NewArray()
+	arraySetTyped(&arr, typedElementFor(reflect.TypeFor[T]()))
+	return TypedArray[T]{Array: arr}
+}
+
+// NewTypedArrayWithArray returns a TypedArray holding the elements of from.
+// An array already typed with T is shared like NewArrayWithArray does; any
+// other array is copied into a new TypedArray, converting each element.
+func NewTypedArrayWithArray[T any](from Array) TypedArray[T] {
+	elem := typedElementFor(reflect.TypeFor[T]())
+	if elem.matchesArray(&from) {
+		return TypedArray[T]{Array: NewArrayWithArray(from)}
+	}
+	arr := NewArray()
+	arraySetTyped(&arr, elem)
+	arr.Assign(from)
+	return TypedArray[T]{Array: arr}
+}
+
+// Get returns the element at index.
+func (cx *TypedArray[T]) Get(index int64) T {
+	v := cx.Array.Get(index)
+	defer v.Destroy()
+	return typedValue[T](typedElementFor(reflect.TypeFor[T]()).fromVariant(&v))
+}
+
+// Set replaces the element at index with value.
+func (cx *TypedArray[T]) Set(index int64, value T) {
+	v := typedElementFor(reflect.TypeFor[T]()).toVariant(reflect.ValueOf(&value).Elem())
+	defer v.Destroy()
+	cx.Array.Set(index, v)
+}
+
+// Append adds value at the end of the array.
+func (cx *TypedArray[T]) Append(value T) {
+	v := typedElementFor(reflect.TypeFor[T]()).toVariant(reflect.ValueOf(&value).Elem())
+	defer v.Destroy()
+	cx.Array.Append(v)
+}
+
+func (cx *TypedArray[T]) containerVariantType() GDExtensionVariantType {
+	return GDEXTENSION_VARIANT_TYPE_ARRAY
+}
+
+func (cx *TypedArray[T]) elementTypes() []TypedElementType {
+	return []TypedElementType{typedElementFor(reflect.TypeFor[T]()).TypedElementType}
+}
+
+func (cx *TypedArray[T]) retyped(ptr GDExtensionConstTypePtr) reflect.Value {
+	return reflect.ValueOf(NewTypedArrayWithArray[T](*(*Array)(unsafe.Pointer(ptr))))
+}
+
+func (cx *TypedArray[T]) empty() reflect.Value {
+	return reflect.ValueOf(NewTypedArray[T]())
+}
+
+// TypedDictionary is a Dictionary whose keys are all of type K and values of
+// type V, typed on the engine side like TypedArray. The methods of
+// Dictionary are promoted except Get, Set, Has and Erase, which take and
+// return K and V.
+type TypedDictionary[K, V any] struct {
+	Dictionary
+}
+
+// NewTypedDictionary returns a new empty TypedDictionary.
+func NewTypedDictionary[K, V any]() TypedDictionary[K, V] {
+	dict := NewDictionary()
+	dictionarySetTyped(&dict, typedElementFor(reflect.TypeFor[K]()), typedElementFor(reflect.TypeFor[V]()))
+	return TypedDictionary[K, V]{Dictionary: dict}
+}
+
+// NewTypedDictionaryWithDictionary returns a TypedDictionary holding the
+// entries of from. A dictionary already typed with K and V is shared like
+// NewDictionaryWithDictionary does; any other dictionary is copied into a
+// new TypedDictionary, converting each key and value.
+func NewTypedDictionaryWithDictionary[K, V any](from Dictionary) TypedDictionary[K, V] {
+	key := typedElementFor(reflect.TypeFor[K]())
+	value := typedElementFor(reflect.TypeFor[V]())
+	if key.matchesDictionaryKey(&from) && value.matchesDictionaryValue(&from) {
+		return TypedDictionary[K, V]{Dictionary: NewDictionaryWithDictionary(from)}
+	}
+	dict := NewDictionary()
+	dictionarySetTyped(&dict, key, value)
+	dict.Assign(from)
+	return TypedDictionary[K, V]{Dictionary: dict}
+}
+
+// Get returns the value of key; ok is false when the dictionary has no such
+// key.
+func (cx *TypedDictionary[K, V]) Get(key K) (value V, ok bool) {
+	k := typedElementFor(reflect.TypeFor[K]()).toVariant(reflect.ValueOf(&key).Elem())
+	defer k.Destroy()
+	if !cx.Dictionary.Has(k) {
+		return value, false
+	}
+	missing := NewVariantNil()
+	defer missing.Destroy()
+	v := cx.Dictionary.Get(k, missing)
+	defer v.Destroy()
+	return typedValue[V](typedElementFor(reflect.TypeFor[V]()).fromVariant(&v)), true
+}
+
+// Set sets the value of key; false is returned when the dictionary is read
+// only.
+func (cx *TypedDictionary[K, V]) Set(key K, value V) bool {
+	k := typedElementFor(reflect.TypeFor[K]()).toVariant(reflect.ValueOf(&key).Elem())
+	defer k.Destroy()
+	v := typedElementFor(reflect.TypeFor[V]()).toVariant(reflect.ValueOf(&value).Elem())
+	defer v.Destroy()
+	return cx.Dictionary.Set(k, v)
+}
+
+// Has reports whether the dictionary has key.
+func (cx *TypedDictionary[K, V]) Has(key K) bool {
+	k := typedElementFor(reflect.TypeFor[K]()).toVariant(reflect.ValueOf(&key).Elem())
+	defer k.Destroy()
+	return cx.Dictionary.Has(k)
+}
+
+// Erase removes key and reports whether it was present.
+func (cx *TypedDictionary[K, V]) Erase(key K) bool {
+	k := typedElementFor(reflect.TypeFor[K]()).toVariant(reflect.ValueOf(&key).Elem())
+	defer k.Destroy()
+	return cx.Dictionary.Erase(k)
+}
+
+func (cx *TypedDictionary[K, V]) containerVariantType() GDExtensionVariantType {
+	return GDEXTENSION_VARIANT_TYPE_DICTIONARY
+}
+
+func (cx *TypedDictionary[K, V]) elementTypes() []TypedElementType {
+	return []TypedElementType{
+		typedElementFor(reflect.TypeFor[K]()).TypedElementType,
+		typedElementFor(reflect.TypeFor[V]()).TypedElementType,
+	}
+}
+
+func (cx *TypedDictionary[K, V]) retyped(ptr GDExtensionConstTypePtr) reflect.Value {
+	return reflect.ValueOf(NewTypedDictionaryWithDictionary[K, V](*(*Dictionary)(unsafe.Pointer(ptr))))
+}
+
+func (cx *TypedDictionary[K, V]) empty() reflect.Value {
+	return reflect.ValueOf(NewTypedDictionary[K, V]())
+}
+
+// typedContainer is implemented by every TypedArray and TypedDictionary.
+type typedContainer interface {
+	containerVariantType() GDExtensionVariantType
+	elementTypes() []TypedElementType
+	// retyped returns a new container of the same type holding the elements
+	// of the Array or Dictionary ptr points to.
+	retyped(ptr GDExtensionConstTypePtr) reflect.Value
+	empty() reflect.Value
+}
+
+// TypedElementType is the type a typed container holds: an element of a
+// TypedArray or a key or value of a TypedDictionary. ClassName is set for
+// objects and VariantType is GDEXTENSION_VARIANT_TYPE_NIL for untyped
+// elements.
+type TypedElementType struct {
+	VariantType GDExtensionVariantType
+	ClassName   string
+}
+
+// typedElement converts the Go elements of a typed container.
+type typedElement struct {
+	TypedElementType
+	encoder callableEncoder
+}
+
+var typedElements sync.Map
+
+func typedElementFor(t reflect.Type) *typedElement {
+	if e, ok := typedElements.Load(t); ok {
+		return e.(*typedElement)
+	}
+	var e typedElement
+	if t.Implements(callableObjectType) {
+		e.VariantType = GDEXTENSION_VARIANT_TYPE_OBJECT
+		e.ClassName = ObjectClassName(t)
+		e.encoder = callableEncoder{
+			variantType: GDEXTENSION_VARIANT_TYPE_OBJECT,
+			goType:      t,
+			encoder:     ObjectEncoder,
+		}
+	} else {
+		enc, ok := callableEncoderFor(t)
+		if !ok {
+			log.Panic("unsupported typed container element type", zap.Any("type", t))
+		}
+		e.VariantType = enc.variantType
+		e.encoder = enc
+	}
+	actual, _ := typedElements.LoadOrStore(t, &e)
+	return actual.(*typedElement)
+}
+
+func (e *typedElement) toVariant(rv reflect.Value) Variant {
+	var ret Variant
+	e.encoder.encode(rv, (GDExtensionUninitializedVariantPtr)(ret.NativePtr()))
+	return ret
+}
+
+func (e *typedElement) fromVariant(v *Variant) reflect.Value {
+	rv, ok := e.encoder.decode(e.encoder.goType, v.NativeConstPtr())
+	if !ok {
+		log.Panic("unexpected typed container element",
+			zap.String("variant_type", GDExtensionVariantTypeStringMap[v.GetType()]),
+			zap.Any("type", e.encoder.goType),
+		)
+	}
+	return rv
+}
+
+func (e *typedElement) matches(vt int64, className StringName) bool {
+	defer className.Destroy()
+	if GDExtensionVariantType(vt) != e.VariantType {
+		return false
+	}
+	return e.VariantType != GDEXTENSION_VARIANT_TYPE_OBJECT || className.ToUtf8() == e.ClassName
+}
+
+func (e *typedElement) matchesArray(arr *Array) bool {
+	return e.matches(arr.GetTypedBuiltin(), arr.GetTypedClassName())
+}
+
+func (e *typedElement) matchesDictionaryKey(dict *Dictionary) bool {
+	return e.matches(dict.GetTypedKeyBuiltin(), dict.GetTypedKeyClassName())
+}
+
+func (e *typedElement) matchesDictionaryValue(dict *Dictionary) bool {
+	return e.matches(dict.GetTypedValueBuiltin(), dict.GetTypedValueClassName())
+}
+
+// typedValue returns rv as a T; rv may hold a nil interface.
+func typedValue[T any](rv reflect.Value) T {
+	var ret T
+	reflect.ValueOf(&ret).Elem().Set(rv)
+	return ret
+}
+
+func arraySetTyped(arr *Array, elem *typedElement) {
+	if elem.VariantType == GDEXTENSION_VARIANT_TYPE_NIL {
+		return
+	}
+	cn := NewStringNameWithUtf8Chars(elem.ClassName)
+	defer cn.Destroy()
+	script := NewVariantNil()
+	defer script.Destroy()
+	CallFunc_GDExtensionInterfaceArraySetTyped(
+		arr.NativePtr(),
+		elem.VariantType,
+		cn.AsGDExtensionConstStringNamePtr(),
+		script.NativeConstPtr(),
+	)
+}
+
+func dictionarySetTyped(dict *Dictionary, key, value *typedElement) {
+	if key.VariantType == GDEXTENSION_VARIANT_TYPE_NIL && value.VariantType == GDEXTENSION_VARIANT_TYPE_NIL {
+		return
+	}
+	keyClassName := NewStringNameWithUtf8Chars(key.ClassName)
+	defer keyClassName.Destroy()
+	valueClassName := NewStringNameWithUtf8Chars(value.ClassName)
+	defer valueClassName.Destroy()
+	script := NewVariantNil()
+	defer script.Destroy()
+	CallFunc_GDExtensionInterfaceDictionarySetTyped(
+		dict.NativePtr(),
+		key.VariantType,
+		keyClassName.AsGDExtensionConstStringNamePtr(),
+		script.NativeConstPtr(),
+		value.VariantType,
+		valueClassName.AsGDExtensionConstStringNamePtr(),
+		script.NativeConstPtr(),
+	)
+}
+
+// TypedContainerEncoder converts a TypedArray or TypedDictionary type to and
+// from its engine container. Decoded containers are always new typed copies
+// owned by the caller.
+type TypedContainerEncoder struct {
+	goType    reflect.Type
+	container typedContainer
+	base      ArgumentEncoder
+}
+
+// TypedContainerEncoder implements ArgumentEncoder evidence
+var _ ArgumentEncoder = (*TypedContainerEncoder)(nil)
+
+// TypedContainerEncoderFor returns the encoder of t; ok is false when t is
+// not a TypedArray or TypedDictionary.
+func TypedContainerEncoderFor(t reflect.Type) (e *TypedContainerEncoder, ok bool) {
+	if t.Kind() != reflect.Struct {
+		return nil, false
+	}
+	c, ok := reflect.New(t).Interface().(typedContainer)
+	if !ok {
+		return nil, false
+	}
+	e = &TypedContainerEncoder{goType: t, container: c}
+	if c.containerVariantType() == GDEXTENSION_VARIANT_TYPE_ARRAY {
+		e.base = ArrayEncoder
+	} else {
+		e.base = DictionaryEncoder
+	}
+	return e, true
+}
+
+// VariantType returns GDEXTENSION_VARIANT_TYPE_ARRAY or
+// GDEXTENSION_VARIANT_TYPE_DICTIONARY.
+func (e *TypedContainerEncoder) VariantType() GDExtensionVariantType {
+	return e.container.containerVariantType()
+}
+
+// ElementTypes returns the element type of a TypedArray or the key and value
+// types of a TypedDictionary.
+func (e *TypedContainerEncoder) ElementTypes() []TypedElementType {
+	return e.container.elementTypes()
+}
+
+// DecodeVariant converts the container held by v to a new value of the type
+// of the encoder; nil converts to an empty container.
+func (e *TypedContainerEncoder) DecodeVariant(v *Variant) (reflect.Value, error) {
+	switch v.GetType() {
+	case GDEXTENSION_VARIANT_TYPE_NIL:
+		return e.container.empty(), nil
+	case e.VariantType():
+	default:
+		return reflect.Value{}, fmt.Errorf("unable to convert %s to %v",
+			GDExtensionVariantTypeStringMap[v.GetType()], e.goType)
+	}
+	if e.VariantType() == GDEXTENSION_VARIANT_TYPE_ARRAY {
+		arr := v.ToArray()
+		defer arr.Destroy()
+		return e.container.retyped(arr.NativeConstPtr()), nil
+	}
+	dict := v.ToDictionary()
+	defer dict.Destroy()
+	return e.container.retyped((GDExtensionConstTypePtr)(dict.NativePtr())), nil
+}
+
+// DecodeReflectTypePtr converts the container ptr points to to a new value
+// of the type of the encoder, like DecodeReflectVariantPtr; the caller has to
+// destroy it.
+func (e *TypedContainerEncoder) DecodeReflectTypePtr(ptr GDExtensionConstTypePtr) reflect.Value {
+	return e.container.retyped(ptr)
+}
+
+func (e *TypedContainerEncoder) EncodeReflectTypePtrArg(rv reflect.Value, pOut GDExtensionUninitializedTypePtr) {
+	e.base.EncodeReflectTypePtrArg(rv.Field(0), pOut)
+}
+
+func (e *TypedContainerEncoder) EncodeReflectTypePtr(rv reflect.Value) GDExtensionTypePtr {
+	return e.base.EncodeReflectTypePtr(rv.Field(0))
+}
+
+func (e *TypedContainerEncoder) DecodeReflectVariantPtr(ptr GDExtensionConstVariantPtr) reflect.Value {
+	rv, err := e.DecodeVariant((*Variant)(unsafe.Pointer(ptr)))
+	if err != nil {
+		log.Panic("unable to decode typed container", zap.Error(err))
+	}
+	return rv
+}
+
+func (e *TypedContainerEncoder) EncodeReflectVariantPtrArg(rv reflect.Value, pOut GDExtensionUninitializedVariantPtr) {
+	e.base.EncodeReflectVariantPtrArg(rv.Field(0), pOut)
+}
+
+func (e *TypedContainerEncoder) EncodeReflectVariantPtr(rv reflect.Value) GDExtensionVariantPtr {
+	return e.base.EncodeReflectVariantPtr(rv.Field(0))
+}
@@ -28,6 +28,7 @@ func VariantInitBindings() {
 	}
 	initPrimativeTypeEncoders()
 	initBuiltinClassEncoders()
+	initTypeEncoders()
 	builtinClassesInitBindings()
 }
 
@@ -66,6 +67,33 @@ func NewVariantCopyWithGDExtensionConstVariantPtr(ptr GDExtensionConstVariantPtr
 	return ret
 }
 
+// NewVariantConstructed constructs a Variant of type vt from args like the
+// builtin constructors of GDScript do, e.g. PackedInt64Array(array).
+func NewVariantConstructed(vt GDExtensionVariantType, args ...Variant) (Variant, error) {
+	var (
+		ret     Variant
+		callErr GDExtensionCallError
+		p       runtime.Pinner
+	)
+	defer p.Unpin()
+	argPtrs := make([]GDExtensionConstVariantPtr, len(args))
+	for i := range args {
+		p.Pin(&args[i])
+		argPtrs[i] = args[i].NativeConstPtr()
+	}
+	CallFunc_GDExtensionInterfaceVariantConstruct(
+		vt,
+		(GDExtensionUninitializedVariantPtr)(ret.NativePtr()),
+		unsafe.SliceData(argPtrs),
+		int32(len(argPtrs)),
+		&callErr,
+	)
+	if !callErr.Ok() {
+		return ret, callErr
+	}
+	return ret, nil
+}
+
 func NewVariantGodotObject(owner *GodotObject) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
@@ -100,11 +128,13 @@ func (c *Variant) ToObject() Object {
 		(GDExtensionUninitializedTypePtr)(engineObjectPtr),
 		c.NativePtr(),
 	)
-	ret := getObjectInstanceBinding(engineObject)
+	ret := GetObjectInstanceBinding(engineObject)
 	return ret
 }
 
-func getObjectInstanceBinding(engineObject *GodotObject) Object {
+// GetObjectInstanceBinding returns the Go wrapper of engineObject, creating
+// the instance binding on first use; nil is returned for a nil engineObject.
+func GetObjectInstanceBinding(engineObject *GodotObject) Object {
 	if engineObject == nil {
 		return nil
 	}
@@ -405,6 +435,41 @@ func (c *Variant) Stringify() string {
 	return ret.ToUtf8()
 }
 
+// Equal reports whether the Variant equals other like the == operator of
+// GDScript: ints and floats compare by value and strings and containers by
+// content. Values that cannot be compared are not equal.
+func (c *Variant) Equal(other Variant) bool {
+	var (
+		ret   Variant
+		valid GDExtensionBool
+	)
+	CallFunc_GDExtensionInterfaceVariantEvaluate(
+		GDEXTENSION_VARIANT_OP_EQUAL,
+		c.NativeConstPtr(),
+		other.NativeConstPtr(),
+		(GDExtensionUninitializedVariantPtr)(ret.NativePtr()),
+		&valid,
+	)
+	return valid != 0 && ret.ToBool()
+}
+
+// Hash returns the hash Godot keys dictionaries with.
+func (c *Variant) Hash() int64 {
+	return int64(CallFunc_GDExtensionInterfaceVariantHash(c.NativeConstPtr()))
+}
+
+// RecursiveHash is Hash hashing nested containers at most recursionCount
+// levels deep.
+func (c *Variant) RecursiveHash(recursionCount int64) int64 {
+	return int64(CallFunc_GDExtensionInterfaceVariantRecursiveHash(c.NativeConstPtr(), GDExtensionInt(recursionCount)))
+}
+
+// HashCompare reports whether the Variant and other are the same Dictionary
+// key: unlike Equal, the types have to match and NaN equals NaN.
+func (c *Variant) HashCompare(other Variant) bool {
+	return CallFunc_GDExtensionInterfaceVariantHashCompare(c.NativeConstPtr(), other.NativeConstPtr()) != 0
+}
+
 func (c *Variant) IsNil() bool {
 	if c == nil {
 		return true
@@ -152,3 +152,81 @@ func initPrimativeTypeEncoders() {
 	ObjectEncoder = CreateObjectEncoder[Object]()
 	VariantEncoder = createVariantEncoder()
 }
+
+// typeEncoder is the encoder of a Go type along with the type of Variant its
+// values are converted to.
+type typeEncoder struct {
+	variantType GDExtensionVariantType
+	encoder     ArgumentEncoder
+}
+
+// typeEncoders is the single table of the encoders of basic Go types and
+// builtin classes; reflection based conversions look types up through
+// EncoderForType.
+var typeEncoders map[reflect.Type]typeEncoder
+
+// initTypeEncoders can only run once the encoders have been created.
+func initTypeEncoders() {
+	typeEncoders = map[reflect.Type]typeEncoder{}
+	add := func(vt GDExtensionVariantType, t reflect.Type, enc ArgumentEncoder) {
+		typeEncoders[t] = typeEncoder{variantType: vt, encoder: enc}
+	}
+	add(GDEXTENSION_VARIANT_TYPE_BOOL, reflect.TypeFor[bool](), BoolEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_INT, reflect.TypeFor[uint](), UintEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_INT, reflect.TypeFor[int](), IntEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_INT, reflect.TypeFor[uint8](), Uint8Encoder)
+	add(GDEXTENSION_VARIANT_TYPE_INT, reflect.TypeFor[int8](), Int8Encoder)
+	add(GDEXTENSION_VARIANT_TYPE_INT, reflect.TypeFor[uint16](), Uint16Encoder)
+	add(GDEXTENSION_VARIANT_TYPE_INT, reflect.TypeFor[int16](), Int16Encoder)
+	add(GDEXTENSION_VARIANT_TYPE_INT, reflect.TypeFor[uint32](), Uint32Encoder)
+	add(GDEXTENSION_VARIANT_TYPE_INT, reflect.TypeFor[int32](), Int32Encoder)
+	add(GDEXTENSION_VARIANT_TYPE_INT, reflect.TypeFor[uint64](), Uint64Encoder)
+	add(GDEXTENSION_VARIANT_TYPE_INT, reflect.TypeFor[int64](), Int64Encoder)
+	add(GDEXTENSION_VARIANT_TYPE_FLOAT, reflect.TypeFor[float32](), Float32Encoder)
+	add(GDEXTENSION_VARIANT_TYPE_FLOAT, reflect.TypeFor[float64](), Float64Encoder)
+	add(GDEXTENSION_VARIANT_TYPE_STRING, reflect.TypeFor[string](), GoStringUtf8Encoder)
+	add(GDEXTENSION_VARIANT_TYPE_NIL, reflect.TypeFor[Variant](), VariantEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_STRING, reflect.TypeFor[String](), StringEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_VECTOR2, reflect.TypeFor[Vector2](), Vector2Encoder)
+	add(GDEXTENSION_VARIANT_TYPE_VECTOR2I, reflect.TypeFor[Vector2i](), Vector2iEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_RECT2, reflect.TypeFor[Rect2](), Rect2Encoder)
+	add(GDEXTENSION_VARIANT_TYPE_RECT2I, reflect.TypeFor[Rect2i](), Rect2iEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_VECTOR3, reflect.TypeFor[Vector3](), Vector3Encoder)
+	add(GDEXTENSION_VARIANT_TYPE_VECTOR3I, reflect.TypeFor[Vector3i](), Vector3iEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_TRANSFORM2D, reflect.TypeFor[Transform2D](), Transform2DEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_VECTOR4, reflect.TypeFor[Vector4](), Vector4Encoder)
+	add(GDEXTENSION_VARIANT_TYPE_VECTOR4I, reflect.TypeFor[Vector4i](), Vector4iEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_PLANE, reflect.TypeFor[Plane](), PlaneEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_QUATERNION, reflect.TypeFor[Quaternion](), QuaternionEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_AABB, reflect.TypeFor[AABB](), AABBEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_BASIS, reflect.TypeFor[Basis](), BasisEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_TRANSFORM3D, reflect.TypeFor[Transform3D](), Transform3DEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_PROJECTION, reflect.TypeFor[Projection](), ProjectionEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_COLOR, reflect.TypeFor[Color](), ColorEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_STRING_NAME, reflect.TypeFor[StringName](), StringNameEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_NODE_PATH, reflect.TypeFor[NodePath](), NodePathEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_RID, reflect.TypeFor[RID](), RIDEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_CALLABLE, reflect.TypeFor[Callable](), CallableEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_SIGNAL, reflect.TypeFor[Signal](), SignalEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_DICTIONARY, reflect.TypeFor[Dictionary](), DictionaryEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_ARRAY, reflect.TypeFor[Array](), ArrayEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_PACKED_BYTE_ARRAY, reflect.TypeFor[PackedByteArray](), PackedByteArrayEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_PACKED_INT32_ARRAY, reflect.TypeFor[PackedInt32Array](), PackedInt32ArrayEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_PACKED_INT64_ARRAY, reflect.TypeFor[PackedInt64Array](), PackedInt64ArrayEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_PACKED_FLOAT32_ARRAY, reflect.TypeFor[PackedFloat32Array](), PackedFloat32ArrayEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_PACKED_FLOAT64_ARRAY, reflect.TypeFor[PackedFloat64Array](), PackedFloat64ArrayEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_PACKED_STRING_ARRAY, reflect.TypeFor[PackedStringArray](), PackedStringArrayEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_PACKED_VECTOR2_ARRAY, reflect.TypeFor[PackedVector2Array](), PackedVector2ArrayEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_PACKED_VECTOR3_ARRAY, reflect.TypeFor[PackedVector3Array](), PackedVector3ArrayEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_PACKED_COLOR_ARRAY, reflect.TypeFor[PackedColorArray](), PackedColorArrayEncoder)
+	add(GDEXTENSION_VARIANT_TYPE_PACKED_VECTOR4_ARRAY, reflect.TypeFor[PackedVector4Array](), PackedVector4ArrayEncoder)
+}
+
+// EncoderForType returns the encoder of t and the type of Variant its values
+// are converted to when t is a basic Go type or a builtin class; Variant
+// reports GDEXTENSION_VARIANT_TYPE_NIL as it holds any value. Named types are
+// not looked up.
+func EncoderForType(t reflect.Type) (enc ArgumentEncoder, vt GDExtensionVariantType, ok bool) {
+	e, ok := typeEncoders[t]
+	return e.encoder, e.variantType, ok
+}
@@ -0,0 +1,182 @@
+package builtin
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"slices"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// VariantKey is a comparable copy of the value of a Variant to key Go maps
+// and sets with:
+//
+//	cache := map[VariantKey]Texture2D{}
+//	cache[NewVariantKey(path)] = texture
+//
+// Two keys are equal when their Variants have the same type and value, like
+// Dictionary keys: strings and containers compare by content, dictionaries
+// whatever the order of their entries, objects by instance and floats so
+// that NaN equals NaN and -0 equals 0. Vectors and the other math types
+// compare their components bitwise and custom callables, such as bound ones,
+// compare by hash. A key does not keep a reference to the Variant.
+type VariantKey struct {
+	vt  GDExtensionVariantType
+	key string
+}
+
+// maxVariantKeyDepth bounds how deep nested containers are encoded, like
+// the recursion limit of the engine.
+const maxVariantKeyDepth = 100
+
+// variantKeyValueSizes are the sizes of the types compared bitwise.
+var variantKeyValueSizes = map[GDExtensionVariantType]int{
+	GDEXTENSION_VARIANT_TYPE_VECTOR2:     Vector2Size,
+	GDEXTENSION_VARIANT_TYPE_VECTOR2I:    Vector2iSize,
+	GDEXTENSION_VARIANT_TYPE_RECT2:       Rect2Size,
+	GDEXTENSION_VARIANT_TYPE_RECT2I:      Rect2iSize,
+	GDEXTENSION_VARIANT_TYPE_VECTOR3:     Vector3Size,
+	GDEXTENSION_VARIANT_TYPE_VECTOR3I:    Vector3iSize,
+	GDEXTENSION_VARIANT_TYPE_TRANSFORM2D: Transform2DSize,
+	GDEXTENSION_VARIANT_TYPE_VECTOR4:     Vector4Size,
+	GDEXTENSION_VARIANT_TYPE_VECTOR4I:    Vector4iSize,
+	GDEXTENSION_VARIANT_TYPE_PLANE:       PlaneSize,
+	GDEXTENSION_VARIANT_TYPE_QUATERNION:  QuaternionSize,
+	GDEXTENSION_VARIANT_TYPE_AABB:        AABBSize,
+	GDEXTENSION_VARIANT_TYPE_BASIS:       BasisSize,
+	GDEXTENSION_VARIANT_TYPE_TRANSFORM3D: Transform3DSize,
+	GDEXTENSION_VARIANT_TYPE_PROJECTION:  ProjectionSize,
+	GDEXTENSION_VARIANT_TYPE_COLOR:       ColorSize,
+	GDEXTENSION_VARIANT_TYPE_RID:         RIDSize,
+}
+
+// NewVariantKey returns the key of the value of v. It panics when v holds
+// containers nested too deep, such as an array containing itself.
+func NewVariantKey(v Variant) VariantKey {
+	return VariantKey{
+		vt:  v.GetType(),
+		key: string(appendVariantKey(nil, &v, 0)),
+	}
+}
+
+// Key returns the VariantKey of the value of the Variant.
+func (c *Variant) Key() VariantKey {
+	return NewVariantKey(*c)
+}
+
+// Type returns the type of the Variant the key was created from.
+func (k VariantKey) Type() GDExtensionVariantType {
+	return k.vt
+}
+
+func appendVariantKey(b []byte, v *Variant, depth int) []byte {
+	if depth > maxVariantKeyDepth {
+		log.Panic("variant nested too deep to be a key", zap.Int("depth", depth))
+	}
+	vt := v.GetType()
+	b = append(b, byte(vt))
+	switch vt {
+	case GDEXTENSION_VARIANT_TYPE_NIL:
+		return b
+	case GDEXTENSION_VARIANT_TYPE_BOOL:
+		if v.ToBool() {
+			return append(b, 1)
+		}
+		return append(b, 0)
+	case GDEXTENSION_VARIANT_TYPE_INT:
+		return binary.LittleEndian.AppendUint64(b, uint64(v.ToInt64()))
+	case GDEXTENSION_VARIANT_TYPE_FLOAT:
+		return appendFloatKey(b, v.ToFloat64())
+	case GDEXTENSION_VARIANT_TYPE_STRING, GDEXTENSION_VARIANT_TYPE_STRING_NAME, GDEXTENSION_VARIANT_TYPE_NODE_PATH:
+		return appendStringKey(b, v.Stringify())
+	case GDEXTENSION_VARIANT_TYPE_OBJECT:
+		return binary.LittleEndian.AppendUint64(b, uint64(CallFunc_GDExtensionInterfaceVariantGetObjectInstanceId(v.NativeConstPtr())))
+	case GDEXTENSION_VARIANT_TYPE_SIGNAL:
+		s := v.ToSignal()
+		defer s.Destroy()
+		name := s.GetName()
+		defer name.Destroy()
+		b = binary.LittleEndian.AppendUint64(b, uint64(s.GetObjectId()))
+		return appendStringKey(b, name.ToUtf8())
+	case GDEXTENSION_VARIANT_TYPE_CALLABLE:
+		c := v.ToCallable()
+		defer c.Destroy()
+		if !c.IsStandard() {
+			return binary.LittleEndian.AppendUint64(b, uint64(v.Hash()))
+		}
+		method := c.GetMethod()
+		defer method.Destroy()
+		b = binary.LittleEndian.AppendUint64(b, uint64(c.GetObjectId()))
+		return appendStringKey(b, method.ToUtf8())
+	case GDEXTENSION_VARIANT_TYPE_ARRAY:
+		arr := v.ToArray()
+		defer arr.Destroy()
+		return appendArrayKey(b, &arr, depth)
+	case GDEXTENSION_VARIANT_TYPE_DICTIONARY:
+		dict := v.ToDictionary()
+		defer dict.Destroy()
+		// entries are sorted as dictionaries are equal whatever their order
+		var entries [][]byte
+		for k, value := range dict.All() {
+			entry := appendVariantKey(nil, &k, depth+1)
+			entry = appendVariantKey(entry, &value, depth+1)
+			entries = append(entries, entry)
+		}
+		slices.SortFunc(entries, bytes.Compare)
+		b = binary.LittleEndian.AppendUint64(b, uint64(len(entries)))
+		for _, entry := range entries {
+			b = appendStringKey(b, string(entry))
+		}
+		return b
+	}
+	if size, ok := variantKeyValueSizes[vt]; ok {
+		value := make([]byte, size)
+		CallFunc_GDExtensionTypeFromVariantConstructorFunc(
+			typeFromVariantConstructor[vt],
+			(GDExtensionUninitializedTypePtr)(unsafe.Pointer(unsafe.SliceData(value))),
+			v.NativePtr(),
+		)
+		return append(b, value...)
+	}
+	// packed arrays are keyed by their elements
+	converted, err := NewVariantConstructed(GDEXTENSION_VARIANT_TYPE_ARRAY, *v)
+	if err != nil {
+		log.Panic("unable to convert variant to a key",
+			zap.String("type", GDExtensionVariantTypeStringMap[vt]),
+			zap.Error(err),
+		)
+	}
+	defer converted.Destroy()
+	arr := converted.ToArray()
+	defer arr.Destroy()
+	return appendArrayKey(b, &arr, depth)
+}
+
+func appendArrayKey(b []byte, arr *Array, depth int) []byte {
+	b = binary.LittleEndian.AppendUint64(b, uint64(arr.Size()))
+	for _, elem := range arr.All() {
+		b = appendVariantKey(b, &elem, depth+1)
+	}
+	return b
+}
+
+func appendFloatKey(b []byte, f float64) []byte {
+	switch {
+	case f == 0:
+		f = 0
+	case math.IsNaN(f):
+		f = math.NaN()
+	}
+	return binary.LittleEndian.AppendUint64(b, math.Float64bits(f))
+}
+
+// appendStringKey appends s prefixed with its length so that consecutive
+// strings cannot be confused.
+func appendStringKey(b []byte, s string) []byte {
+	b = binary.LittleEndian.AppendUint64(b, uint64(len(s)))
+	return append(b, s...)
+}
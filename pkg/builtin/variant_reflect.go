@@ -0,0 +1,108 @@
+package builtin
+
+import (
+	"reflect"
+
+	. "github.com/godot-go/godot-go/pkg/ffi"
+)
+
+var gdClassType = reflect.TypeFor[GDClass]()
+
+// packedArrayTypes maps the element type of a Go slice to the packed array
+// the slice is converted to.
+var packedArrayTypes = map[reflect.Type]GDExtensionVariantType{
+	reflect.TypeFor[byte]():    GDEXTENSION_VARIANT_TYPE_PACKED_BYTE_ARRAY,
+	reflect.TypeFor[int32]():   GDEXTENSION_VARIANT_TYPE_PACKED_INT32_ARRAY,
+	reflect.TypeFor[int64]():   GDEXTENSION_VARIANT_TYPE_PACKED_INT64_ARRAY,
+	reflect.TypeFor[float32](): GDEXTENSION_VARIANT_TYPE_PACKED_FLOAT32_ARRAY,
+	reflect.TypeFor[float64](): GDEXTENSION_VARIANT_TYPE_PACKED_FLOAT64_ARRAY,
+	reflect.TypeFor[string]():  GDEXTENSION_VARIANT_TYPE_PACKED_STRING_ARRAY,
+	reflect.TypeFor[Vector2](): GDEXTENSION_VARIANT_TYPE_PACKED_VECTOR2_ARRAY,
+	reflect.TypeFor[Vector3](): GDEXTENSION_VARIANT_TYPE_PACKED_VECTOR3_ARRAY,
+	reflect.TypeFor[Color]():   GDEXTENSION_VARIANT_TYPE_PACKED_COLOR_ARRAY,
+	reflect.TypeFor[Vector4](): GDEXTENSION_VARIANT_TYPE_PACKED_VECTOR4_ARRAY,
+}
+
+// PackedArrayVariantType returns the packed array a Go slice with elements of
+// type elem is converted to; ok is false when slices of elem are converted to
+// an Array.
+func PackedArrayVariantType(elem reflect.Type) (vt GDExtensionVariantType, ok bool) {
+	vt, ok = packedArrayTypes[elem]
+	return vt, ok
+}
+
+// GoTypeVariantType returns the Variant type values of t are converted to by
+// NewCallableFromFunc; ok is false when t cannot be converted. Variant
+// reports GDEXTENSION_VARIANT_TYPE_NIL as it accepts any value.
+func GoTypeVariantType(t reflect.Type) (vt GDExtensionVariantType, ok bool) {
+	enc, ok := callableEncoderFor(t)
+	return enc.variantType, ok
+}
+
+// DecodeVariantPtr converts the Variant at ptr into a value of t; ok is false
+// when t is not supported or the Variant holds an incompatible type.
+func DecodeVariantPtr(ptr GDExtensionConstVariantPtr, t reflect.Type) (v reflect.Value, ok bool) {
+	enc, ok := callableEncoderFor(t)
+	if !ok {
+		return reflect.Value{}, false
+	}
+	return enc.decode(t, ptr)
+}
+
+// EncodeVariantPtr writes rv into the Variant at rOut; ok is false when the
+// type of rv is not supported.
+func EncodeVariantPtr(rv reflect.Value, rOut GDExtensionUninitializedVariantPtr) (ok bool) {
+	enc, ok := callableEncoderFor(rv.Type())
+	if !ok {
+		return false
+	}
+	enc.encode(rv, rOut)
+	return true
+}
+
+// ObjectClassName returns the Godot class of the object type t: the engine
+// class of an object interface, the class a Ref points to or the name a Go
+// class registers with.
+func ObjectClassName(t reflect.Type) string {
+	if m, ok := t.MethodByName("TypedPtr"); ok {
+		// Ref interfaces and *TypedRef[T]
+		return ObjectClassName(m.Type.Out(0))
+	}
+	switch t.Kind() {
+	case reflect.Interface:
+		return t.Name()
+	case reflect.Pointer:
+		if t.Implements(gdClassType) && t.Elem().Kind() == reflect.Struct {
+			return reflect.New(t.Elem()).Interface().(GDClass).GetClassName()
+		}
+	}
+	return ""
+}
+
+// VariantFunc calls a Go func with Variant arguments converted the same way
+// as for a Callable created by NewCallableFromFunc, without creating a
+// Callable.
+type VariantFunc struct {
+	c *goCallable
+}
+
+// NewVariantFunc wraps fn; like NewCallableFromFunc, it panics when fn has an
+// argument or return type GoTypeVariantType does not support.
+func NewVariantFunc(fn any) VariantFunc {
+	return VariantFunc{c: newGoCallable(fn)}
+}
+
+// Call calls the func and writes its return value, if any, to rReturn.
+// Argument count and type mismatches are reported through rError.
+func (f VariantFunc) Call(args []GDExtensionConstVariantPtr, rReturn GDExtensionVariantPtr, rError *GDExtensionCallError) {
+	f.c.call(args, rReturn, rError)
+}
+
+// ArgumentCount returns the number of arguments of the func; a variadic func
+// returns the number of fixed arguments and false.
+func (f VariantFunc) ArgumentCount() (count int, ok bool) {
+	if f.c.variadic {
+		return len(f.c.args) - 1, false
+	}
+	return len(f.c.args), true
+}
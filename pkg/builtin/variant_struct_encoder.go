@@ -0,0 +1,422 @@
+package builtin
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	. "github.com/godot-go/godot-go/pkg/util"
+	"go.uber.org/zap"
+)
+
+// StructEncoder converts a plain Go struct to and from a Dictionary keyed by
+// the names of its exported fields. A field is named after its Go name in
+// snake_case unless its godot tag names it:
+//
+//	type HitResult struct {
+//		Position Vector3  `godot:"position"`
+//		Damage   int64    // "damage"
+//		Tags     []string `godot:",omitempty"`
+//		Debug    string   `godot:"-"`
+//	}
+//
+// omitempty leaves zero values and empty slices and maps out of the
+// Dictionary and "-" skips the field. The fields of an exported embedded
+// struct are promoted unless the embedded field has a tag. Nested structs
+// become Dictionaries, TypedArray and TypedDictionary fields keep their
+// engine container, slices become packed arrays like bound method
+// arguments or else an Array, and maps become Dictionaries. When decoding,
+// missing keys leave their field at its zero value and unknown keys are
+// ignored.
+type StructEncoder struct {
+	goType reflect.Type
+	fields []structField
+}
+
+// StructEncoder implements ArgumentEncoder evidence
+var _ ArgumentEncoder = (*StructEncoder)(nil)
+
+type structField struct {
+	index     []int
+	name      string
+	omitEmpty bool
+	codec     structValueCodec
+}
+
+// structValueCodec converts the value of a field, or an element of one, to
+// and from a Variant.
+type structValueCodec struct {
+	encode func(rv reflect.Value, rOut GDExtensionUninitializedVariantPtr)
+	decode func(v *Variant, t reflect.Type) (reflect.Value, error)
+}
+
+var (
+	structEncoders   = map[reflect.Type]*StructEncoder{}
+	structEncodersMu sync.Mutex
+)
+
+// StructEncoderFor returns the encoder of the struct type t, creating it on
+// first use. An error is returned when a field has a type that cannot be
+// converted to a Variant.
+func StructEncoderFor(t reflect.Type) (*StructEncoder, error) {
+	structEncodersMu.Lock()
+	defer structEncodersMu.Unlock()
+	// encoders are only cached once every type they depend on is complete
+	building := map[reflect.Type]*StructEncoder{}
+	e, err := structEncoderFor(t, building)
+	if err != nil {
+		return nil, err
+	}
+	for bt, be := range building {
+		structEncoders[bt] = be
+	}
+	return e, nil
+}
+
+func structEncoderFor(t reflect.Type, building map[reflect.Type]*StructEncoder) (*StructEncoder, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%v is not a struct", t)
+	}
+	if e, ok := structEncoders[t]; ok {
+		return e, nil
+	}
+	if e, ok := building[t]; ok {
+		return e, nil
+	}
+	// added before its fields so recursive types find it
+	e := &StructEncoder{goType: t}
+	building[t] = e
+	fields, err := newStructFields(t, nil, building)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", t, err)
+	}
+	names := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		if _, ok := names[f.name]; ok {
+			return nil, fmt.Errorf("%v: duplicate field name %q", t, f.name)
+		}
+		names[f.name] = struct{}{}
+	}
+	e.fields = fields
+	return e, nil
+}
+
+func newStructFields(t reflect.Type, index []int, building map[reflect.Type]*StructEncoder) ([]structField, error) {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, tagged := f.Tag.Lookup("godot")
+		if tag == "-" || !f.IsExported() {
+			continue
+		}
+		fieldIndex := append(append([]int(nil), index...), i)
+		if f.Anonymous && !tagged && f.Type.Kind() == reflect.Struct {
+			embedded, err := newStructFields(f.Type, fieldIndex, building)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, embedded...)
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if len(name) == 0 {
+			name = SnakeCase(f.Name)
+		}
+		sf := structField{
+			index: fieldIndex,
+			name:  name,
+		}
+		if len(opts) > 0 {
+			for _, opt := range strings.Split(opts, ",") {
+				switch opt {
+				case "omitempty":
+					sf.omitEmpty = true
+				default:
+					return nil, fmt.Errorf("field %s: unknown godot tag option %q", f.Name, opt)
+				}
+			}
+		}
+		codec, err := newStructValueCodec(f.Type, building)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.Name, err)
+		}
+		sf.codec = codec
+		fields = append(fields, sf)
+	}
+	return fields, nil
+}
+
+func newStructValueCodec(t reflect.Type, building map[reflect.Type]*StructEncoder) (structValueCodec, error) {
+	if e, ok := TypedContainerEncoderFor(t); ok {
+		return structValueCodec{
+			encode: e.EncodeReflectVariantPtrArg,
+			decode: func(v *Variant, _ reflect.Type) (reflect.Value, error) {
+				return e.DecodeVariant(v)
+			},
+		}, nil
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		e, err := structEncoderFor(t, building)
+		if err != nil {
+			return structValueCodec{}, err
+		}
+		return structValueCodec{
+			encode: e.EncodeReflectVariantPtrArg,
+			decode: func(v *Variant, _ reflect.Type) (reflect.Value, error) {
+				return e.DecodeVariant(v)
+			},
+		}, nil
+	case reflect.Slice:
+		return newSliceValueCodec(t, building)
+	case reflect.Map:
+		return newMapValueCodec(t, building)
+	}
+	enc, ok := callableEncoderFor(t)
+	if !ok {
+		return structValueCodec{}, fmt.Errorf("unsupported type %v", t)
+	}
+	return structValueCodec{
+		encode: enc.encode,
+		decode: func(v *Variant, t reflect.Type) (reflect.Value, error) {
+			rv, ok := enc.decode(t, v.NativeConstPtr())
+			if !ok {
+				return rv, fmt.Errorf("unable to convert %s to %v", GDExtensionVariantTypeStringMap[v.GetType()], t)
+			}
+			return rv, nil
+		},
+	}, nil
+}
+
+func newSliceValueCodec(t reflect.Type, building map[reflect.Type]*StructEncoder) (structValueCodec, error) {
+	elem, err := newStructValueCodec(t.Elem(), building)
+	if err != nil {
+		return structValueCodec{}, err
+	}
+	packedType, packed := PackedArrayVariantType(t.Elem())
+	encode := func(rv reflect.Value, rOut GDExtensionUninitializedVariantPtr) {
+		arr := NewArray()
+		defer arr.Destroy()
+		for i := 0; i < rv.Len(); i++ {
+			var v Variant
+			elem.encode(rv.Index(i), (GDExtensionUninitializedVariantPtr)(v.NativePtr()))
+			arr.Append(v)
+			v.Destroy()
+		}
+		if !packed {
+			GDExtensionVariantPtrFromArray(arr, rOut)
+			return
+		}
+		untyped := NewVariantArray(arr)
+		defer untyped.Destroy()
+		v, err := NewVariantConstructed(packedType, untyped)
+		if err != nil {
+			log.Panic("unable to convert slice to packed array",
+				zap.Any("type", rv.Type()),
+				zap.Error(err),
+			)
+		}
+		defer v.Destroy()
+		VariantEncoder.EncodeVariantPtrArg(v, rOut)
+	}
+	decode := func(v *Variant, t reflect.Type) (reflect.Value, error) {
+		switch v.GetType() {
+		case GDEXTENSION_VARIANT_TYPE_NIL:
+			return reflect.Zero(t), nil
+		case GDEXTENSION_VARIANT_TYPE_ARRAY:
+		default:
+			converted, err := NewVariantConstructed(GDEXTENSION_VARIANT_TYPE_ARRAY, *v)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("unable to convert %s to %v: %w",
+					GDExtensionVariantTypeStringMap[v.GetType()], t, err)
+			}
+			defer converted.Destroy()
+			v = &converted
+		}
+		arr := v.ToArray()
+		defer arr.Destroy()
+		n := int(arr.Size())
+		out := reflect.MakeSlice(t, n, n)
+		for i := 0; i < n; i++ {
+			item := arr.Get(int64(i))
+			rv, err := elem.decode(&item, t.Elem())
+			item.Destroy()
+			if err != nil {
+				return out, fmt.Errorf("element %d: %w", i, err)
+			}
+			out.Index(i).Set(rv)
+		}
+		return out, nil
+	}
+	return structValueCodec{encode: encode, decode: decode}, nil
+}
+
+func newMapValueCodec(t reflect.Type, building map[reflect.Type]*StructEncoder) (structValueCodec, error) {
+	key, err := newStructValueCodec(t.Key(), building)
+	if err != nil {
+		return structValueCodec{}, err
+	}
+	elem, err := newStructValueCodec(t.Elem(), building)
+	if err != nil {
+		return structValueCodec{}, err
+	}
+	encode := func(rv reflect.Value, rOut GDExtensionUninitializedVariantPtr) {
+		dict := NewDictionary()
+		defer dict.Destroy()
+		iter := rv.MapRange()
+		for iter.Next() {
+			var k, v Variant
+			key.encode(iter.Key(), (GDExtensionUninitializedVariantPtr)(k.NativePtr()))
+			elem.encode(iter.Value(), (GDExtensionUninitializedVariantPtr)(v.NativePtr()))
+			dict.Set(k, v)
+			k.Destroy()
+			v.Destroy()
+		}
+		GDExtensionVariantPtrFromDictionary(dict, rOut)
+	}
+	decode := func(v *Variant, t reflect.Type) (reflect.Value, error) {
+		switch v.GetType() {
+		case GDEXTENSION_VARIANT_TYPE_NIL:
+			return reflect.Zero(t), nil
+		case GDEXTENSION_VARIANT_TYPE_DICTIONARY:
+		default:
+			return reflect.Value{}, fmt.Errorf("unable to convert %s to %v",
+				GDExtensionVariantTypeStringMap[v.GetType()], t)
+		}
+		dict := v.ToDictionary()
+		defer dict.Destroy()
+		keys := dict.Keys()
+		defer keys.Destroy()
+		missing := NewVariantNil()
+		defer missing.Destroy()
+		n := keys.Size()
+		out := reflect.MakeMapWithSize(t, int(n))
+		for i := int64(0); i < n; i++ {
+			k := keys.Get(i)
+			item := dict.Get(k, missing)
+			rk, err := key.decode(&k, t.Key())
+			var rv reflect.Value
+			if err == nil {
+				rv, err = elem.decode(&item, t.Elem())
+			}
+			k.Destroy()
+			item.Destroy()
+			if err != nil {
+				return out, fmt.Errorf("entry %d: %w", i, err)
+			}
+			out.SetMapIndex(rk, rv)
+		}
+		return out, nil
+	}
+	return structValueCodec{encode: encode, decode: decode}, nil
+}
+
+// EncodeDictionary converts the struct rv to a new Dictionary.
+func (e *StructEncoder) EncodeDictionary(rv reflect.Value) Dictionary {
+	dict := NewDictionary()
+	for _, f := range e.fields {
+		fv := rv.FieldByIndex(f.index)
+		if f.omitEmpty && isEmptyStructValue(fv) {
+			continue
+		}
+		key := NewVariantGoString(f.name)
+		var value Variant
+		f.codec.encode(fv, (GDExtensionUninitializedVariantPtr)(value.NativePtr()))
+		dict.Set(key, value)
+		key.Destroy()
+		value.Destroy()
+	}
+	return dict
+}
+
+// DecodeDictionary converts dict to a new struct of the type of the encoder.
+func (e *StructEncoder) DecodeDictionary(dict Dictionary) (reflect.Value, error) {
+	out := reflect.New(e.goType).Elem()
+	missing := NewVariantNil()
+	defer missing.Destroy()
+	for _, f := range e.fields {
+		key := NewVariantGoString(f.name)
+		if !dict.Has(key) {
+			key.Destroy()
+			continue
+		}
+		value := dict.Get(key, missing)
+		fv, err := f.codec.decode(&value, e.goType.FieldByIndex(f.index).Type)
+		key.Destroy()
+		value.Destroy()
+		if err != nil {
+			return out, fmt.Errorf("%v.%s: %w", e.goType, f.name, err)
+		}
+		out.FieldByIndex(f.index).Set(fv)
+	}
+	return out, nil
+}
+
+// DecodeVariant converts the Dictionary held by v to a new struct of the
+// type of the encoder; nil converts to the zero value.
+func (e *StructEncoder) DecodeVariant(v *Variant) (reflect.Value, error) {
+	switch v.GetType() {
+	case GDEXTENSION_VARIANT_TYPE_NIL:
+		return reflect.Zero(e.goType), nil
+	case GDEXTENSION_VARIANT_TYPE_DICTIONARY:
+	default:
+		return reflect.Value{}, fmt.Errorf("unable to convert %s to %v",
+			GDExtensionVariantTypeStringMap[v.GetType()], e.goType)
+	}
+	dict := v.ToDictionary()
+	defer dict.Destroy()
+	return e.DecodeDictionary(dict)
+}
+
+func (e *StructEncoder) DecodeReflectTypePtr(ptr GDExtensionConstTypePtr) reflect.Value {
+	rv, err := e.DecodeDictionary(*(*Dictionary)(unsafe.Pointer(ptr)))
+	if err != nil {
+		log.Panic("unable to decode struct", zap.Error(err))
+	}
+	return rv
+}
+
+func (e *StructEncoder) EncodeReflectTypePtrArg(rv reflect.Value, pOut GDExtensionUninitializedTypePtr) {
+	DictionaryEncoder.EncodeTypePtrArg(e.EncodeDictionary(rv), pOut)
+}
+
+func (e *StructEncoder) EncodeReflectTypePtr(rv reflect.Value) GDExtensionTypePtr {
+	var out Dictionary
+	pOut := (GDExtensionTypePtr)(unsafe.Pointer(&out))
+	e.EncodeReflectTypePtrArg(rv, (GDExtensionUninitializedTypePtr)(pOut))
+	return pOut
+}
+
+func (e *StructEncoder) DecodeReflectVariantPtr(ptr GDExtensionConstVariantPtr) reflect.Value {
+	rv, err := e.DecodeVariant((*Variant)(unsafe.Pointer(ptr)))
+	if err != nil {
+		log.Panic("unable to decode struct", zap.Error(err))
+	}
+	return rv
+}
+
+func (e *StructEncoder) EncodeReflectVariantPtrArg(rv reflect.Value, pOut GDExtensionUninitializedVariantPtr) {
+	dict := e.EncodeDictionary(rv)
+	defer dict.Destroy()
+	GDExtensionVariantPtrFromDictionary(dict, pOut)
+}
+
+func (e *StructEncoder) EncodeReflectVariantPtr(rv reflect.Value) GDExtensionVariantPtr {
+	var out Variant
+	pOut := (GDExtensionVariantPtr)(unsafe.Pointer(&out))
+	e.EncodeReflectVariantPtrArg(rv, (GDExtensionUninitializedVariantPtr)(pOut))
+	return pOut
+}
+
+// isEmptyStructValue reports whether an omitempty field is left out.
+func isEmptyStructValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	}
+	return v.IsZero()
+}
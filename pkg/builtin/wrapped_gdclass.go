@@ -33,8 +33,9 @@ func ObjectClassFromGDExtensionClassInstancePtr(p_instance GDExtensionClassInsta
 }
 
 // WrappedPostInitialize is equivalent to Wrapped::_postinitialize in godot-cpp
-// this should only be called for GDClasses and not GDExtensionClasses
-func WrappedPostInitialize(extensionClassName string, w Wrapped) {
+// this should only be called for GDClasses and not GDExtensionClasses; the
+// class instance pointer set on the owner is returned
+func WrappedPostInitialize(extensionClassName string, w Wrapped) GDExtensionClassInstancePtr {
 	owner := w.GetGodotObjectOwner()
 	if len(extensionClassName) == 0 {
 		log.Panic("extension class name cannot be empty",
@@ -61,11 +62,12 @@ func WrappedPostInitialize(extensionClassName string, w Wrapped) {
 	pnr.Pin(inst)
 	pnr.Pin(cnPtr)
 	instHandle := cgo.NewHandle(inst)
+	instPtr := (GDExtensionClassInstancePtr)(instHandle)
 	if cnPtr != nil {
 		CallFunc_GDExtensionInterfaceObjectSetInstance(
 			(GDExtensionObjectPtr)(owner),
 			cnPtr,
-			(GDExtensionClassInstancePtr)(instHandle),
+			instPtr,
 		)
 	}
 	CallFunc_GDExtensionInterfaceObjectSetInstanceBinding(
@@ -74,6 +76,7 @@ func WrappedPostInitialize(extensionClassName string, w Wrapped) {
 		instHandle,
 		&callbacks,
 	)
+	return instPtr
 }
 
 //export GoCallback_GDClassBindingCreate
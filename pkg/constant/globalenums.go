@@ -0,0 +1,9 @@
+package constant
+
+import "fmt"
+
+// Error implements the error interface so that Go errors can wrap a Godot
+// Error value with fmt.Errorf("...: %w", ERR_FILE_NOT_FOUND).
+func (e Error) Error() string {
+	return fmt.Sprintf("godot error %d", int(e))
+}
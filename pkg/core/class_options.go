@@ -0,0 +1,69 @@
+package core
+
+// ClassOptions describes how a registered class is exposed to the engine.
+type ClassOptions struct {
+	// Virtual classes are meant to be extended by scripts implementing their
+	// virtual methods.
+	Virtual bool
+	// Abstract classes cannot be instantiated; they are hidden from the
+	// Create dialogs of the editor.
+	Abstract bool
+	// Exposed classes are listed by the editor and documentation.
+	Exposed bool
+	// Runtime classes only run in games; the editor gets placeholder
+	// instances that store property values.
+	Runtime bool
+	// IconPath is the resource path of the editor icon.
+	IconPath string
+}
+
+// ClassOption configures the ClassOptions of ClassDBRegisterClass.
+type ClassOption func(*ClassOptions)
+
+func newClassOptions(opts []ClassOption) ClassOptions {
+	co := ClassOptions{
+		Exposed: true,
+	}
+	for _, opt := range opts {
+		opt(&co)
+	}
+	return co
+}
+
+// ClassVirtual registers a virtual class like GDREGISTER_VIRTUAL_CLASS.
+func ClassVirtual() ClassOption {
+	return func(co *ClassOptions) {
+		co.Virtual = true
+	}
+}
+
+// ClassAbstract registers an abstract class like GDREGISTER_ABSTRACT_CLASS;
+// it can only be used as a base class.
+func ClassAbstract() ClassOption {
+	return func(co *ClassOptions) {
+		co.Abstract = true
+	}
+}
+
+// ClassInternal registers an internal helper class like
+// GDREGISTER_INTERNAL_CLASS; it is hidden from the editor and documentation.
+func ClassInternal() ClassOption {
+	return func(co *ClassOptions) {
+		co.Exposed = false
+	}
+}
+
+// ClassRuntime registers a runtime class like GDREGISTER_RUNTIME_CLASS; its
+// instances do not run in the editor.
+func ClassRuntime() ClassOption {
+	return func(co *ClassOptions) {
+		co.Runtime = true
+	}
+}
+
+// ClassIcon sets the editor icon, e.g. "res://icons/example.svg".
+func ClassIcon(path string) ClassOption {
+	return func(co *ClassOptions) {
+		co.IconPath = path
+	}
+}
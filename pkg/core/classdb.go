@@ -9,6 +9,7 @@ import "C"
 import (
 	"fmt"
 	"reflect"
+	"runtime"
 	"strings"
 	"unsafe"
 
@@ -17,6 +18,7 @@ import (
 	. "github.com/godot-go/godot-go/pkg/ffi"
 	. "github.com/godot-go/godot-go/pkg/gdclassinit"
 	"github.com/godot-go/godot-go/pkg/log"
+	. "github.com/godot-go/godot-go/pkg/util"
 	"go.uber.org/zap"
 )
 
@@ -68,13 +70,15 @@ func ClassDBAddPropertySubgroup(t GDClass, p_name string, p_prefix string) {
 	)
 }
 
-// ClassDBAddProperty default p_index = -1
+// ClassDBAddProperty default p_index = -1. Options set the inspector hint,
+// hint string, usage flags and class name of the property.
 func ClassDBAddProperty(
 	inst GDClass,
 	p_property_type GDExtensionVariantType,
 	p_property_name string,
 	p_setter string,
 	p_getter string,
+	opts ...PropertyOption,
 ) {
 	t := reflect.TypeOf(inst)
 	cn := inst.GetClassName()
@@ -122,22 +126,34 @@ func ClassDBAddProperty(
 	}
 	// register property with plugin
 	ci.PropertyNameSet[pn] = struct{}{}
-	className := NewStringNameWithLatin1Chars(cn)
+	po := newPropertyOptions(opts)
+	if po.Default != nil {
+		ci.PropertyDefaults[pn] = PropertyDefault{
+			Value:  NewVariantNativeCopy(po.Default.NativeConstPtr()),
+			Getter: getter,
+		}
+	}
+	className := NewStringNameWithLatin1Chars(po.propertyClassName(cn))
 	defer className.Destroy()
 	propName := NewStringNameWithLatin1Chars(pn)
 	defer propName.Destroy()
-	hint := NewStringWithUtf8Chars("")
+	hint := NewStringWithUtf8Chars(po.HintString)
 	defer hint.Destroy()
 	// register with Godot
 	prop_info := NewGDExtensionPropertyInfo(
 		className.AsGDExtensionConstStringNamePtr(),
 		p_property_type,
 		propName.AsGDExtensionConstStringNamePtr(),
-		uint32(PROPERTY_HINT_NONE),
+		uint32(po.Hint),
 		hint.AsGDExtensionConstStringPtr(),
-		uint32(PROPERTY_USAGE_DEFAULT),
+		uint32(po.Usage),
 	)
-	snSetterGDName := NewStringNameWithLatin1Chars(setter.GdMethodName)
+	// an empty setter name registers a read-only property
+	var setterGDName string
+	if setter != nil {
+		setterGDName = setter.GdMethodName
+	}
+	snSetterGDName := NewStringNameWithLatin1Chars(setterGDName)
 	defer snSetterGDName.Destroy()
 	snGetterGDName := NewStringNameWithLatin1Chars(getter.GdMethodName)
 	defer snGetterGDName.Destroy()
@@ -145,6 +161,9 @@ func ClassDBAddProperty(
 		zap.String("class", cn),
 		zap.String("name", p_property_name),
 		zap.Int("variant_type", int(p_property_type)),
+		zap.Int("hint", int(po.Hint)),
+		zap.String("hint_string", po.HintString),
+		zap.Int("usage", int(po.Usage)),
 	)
 	CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClassPropertyIndexed(
 		FFI.Library,
@@ -156,9 +175,15 @@ func ClassDBAddProperty(
 	)
 }
 
+// SignalParam is an argument of a signal added by ClassDBAddSignal. ClassName
+// names the class of an object argument; Hint and HintString describe the
+// element type of a typed array argument.
 type SignalParam struct {
-	Type GDExtensionVariantType
-	Name string
+	Type       GDExtensionVariantType
+	Name       string
+	ClassName  string
+	Hint       PropertyHint
+	HintString string
 }
 
 func ClassDBAddSignal(t GDClass, signalName string, params ...SignalParam) {
@@ -179,17 +204,17 @@ func ClassDBAddSignal(t GDClass, signalName string, params ...SignalParam) {
 	ci.SignalNameSet[signalName] = struct{}{}
 	paramArr := make([]GDExtensionPropertyInfo, len(params))
 	for i, p := range params {
-		snTypeName := NewStringNameWithLatin1Chars(typeName)
-		defer snTypeName.Destroy()
+		snClassName := NewStringNameWithLatin1Chars(p.ClassName)
+		defer snClassName.Destroy()
 		snName := NewStringNameWithLatin1Chars(p.Name)
 		defer snName.Destroy()
-		hintString := NewStringWithUtf8Chars("")
+		hintString := NewStringWithUtf8Chars(p.HintString)
 		defer hintString.Destroy()
 		paramArr[i] = NewGDExtensionPropertyInfo(
-			snTypeName.AsGDExtensionConstStringNamePtr(),
+			snClassName.AsGDExtensionConstStringNamePtr(),
 			p.Type,
 			snName.AsGDExtensionConstStringNamePtr(),
-			(uint32)(PROPERTY_HINT_NONE),
+			(uint32)(p.Hint),
 			hintString.AsGDExtensionConstStringPtr(),
 			(uint32)(PROPERTY_USAGE_DEFAULT),
 		)
@@ -227,10 +252,49 @@ func ClassDBBindMethod[T GDClass](
 	classDBBindMethod(inst, goMethodName, gdMethodName, METHOD_FLAGS_DEFAULT, argNames, defaultValues)
 }
 
-// TODO: golang does not have static methods
-// func ClassDBBindMethodStatic(inst GDClass, goMethodName string, gdMethodName string, argNames []string, defaultValues []Variant) *MethodBindImpl {
-// 	return classDBBindMethod(inst, goMethodName, gdMethodName, METHOD_FLAG_STATIC, argNames, defaultValues)
-// }
+// ClassDBBindMethodStatic binds a method as a static method in godot. Go has no
+// static methods, so the receiver is always passed in as a zero value and must
+// not be used by the method.
+func ClassDBBindMethodStatic[T GDClass](
+	inst T,
+	goMethodName string,
+	gdMethodName string,
+	argNames []string,
+	defaultValues []Variant,
+) {
+	classDBBindMethod(inst, goMethodName, gdMethodName, METHOD_FLAG_STATIC, argNames, defaultValues)
+}
+
+// ClassDBBindStaticFunc binds a plain go function as a static method of the class in godot.
+func ClassDBBindStaticFunc[T GDClass](
+	inst T,
+	fn any,
+	gdMethodName string,
+	argNames []string,
+	defaultValues []Variant,
+) {
+	className := inst.GetClassName()
+	fv := reflect.ValueOf(fn)
+	if fv.Kind() != reflect.Func || fv.IsNil() {
+		log.Panic("static function must be a non-nil func",
+			zap.String("gdclass", className),
+			zap.String("gd_method_name", gdMethodName),
+		)
+	}
+	goFuncName := runtime.FuncForPC(fv.Pointer()).Name()
+	goFuncName = goFuncName[strings.LastIndex(goFuncName, ".")+1:]
+	methodFlags := METHOD_FLAG_STATIC
+	if fv.Type().IsVariadic() {
+		methodFlags |= METHOD_FLAG_VARARG
+	}
+	log.Debug("ClassDBBindStaticFunc called",
+		zap.String("go_name", goFuncName),
+		zap.String("gd_name", gdMethodName),
+		zap.Any("class", className),
+	)
+	md := NewGoStaticFuncMetadata(fv, className, gdMethodName, goFuncName, argNames, defaultValues, methodFlags)
+	classDBRegisterMethod(className, md)
+}
 
 func ClassDBBindMethodVirtual[T GDClass](
 	inst T,
@@ -282,10 +346,19 @@ func classDBBindMethod[T GDClass](
 		zap.Reflect("method", m),
 	)
 	md := NewGoMethodMetadata(m, className, gdMethodName, goMethodName, argNames, defaultValues, methodFlags)
+	classDBRegisterMethod(className, md)
+}
+
+// classDBRegisterMethod keeps track of the method on the class and registers it with godot.
+func classDBRegisterMethod(className string, md *GoMethodMetadata) {
+	goMethodName := md.GoMethodName
+	gdMethodName := md.GdMethodName
+	methodFlags := md.MethodFlags
 	if md.IsVirtual {
 		if !strings.HasPrefix(goMethodName, "V_") {
 			log.Panic(`virtual method name must have a prefix of "V_".`)
 		}
+		validatePropertyRevertVirtual(md)
 	} else {
 		if strings.HasPrefix(goMethodName, "V_") {
 			log.Panic(`method name cannot have a prefix of "V_".`)
@@ -402,11 +475,15 @@ func classDBBindIntegerConstant(t GDClass, p_enum_name, p_constant_name string,
 	)
 }
 
+// ClassDBRegisterClass registers T with the engine. The class is exposed and
+// can be instantiated unless opts say otherwise, e.g. ClassAbstract for base
+// classes that should not show up in the Create dialogs of the editor.
 func ClassDBRegisterClass[T Object](
 	constructor GDClassGoConstructorFromOwner,
 	propertyList []GDExtensionPropertyInfo,
 	validateProperty func(*GDExtensionPropertyInfo),
 	bindMethodsFunc func(t T),
+	opts ...ClassOption,
 ) {
 	t := reflect.TypeFor[T]()
 	objectInst := reflect.Zero(t).Interface().(Object)
@@ -457,28 +534,48 @@ func ClassDBRegisterClass[T Object](
 	if inheritType == nil {
 		log.Panic("Missing GDExtensionClass interface: inherits type nil")
 	}
-	if fmt.Sprintf("%sImpl", parentName) != inheritType.Name() {
+	switch {
+	case parentPtr != nil:
+		// Go classes extending a registered Go class embed its struct
+		if parentPtr.ClassType != inheritType {
+			log.Panic("GetParentClassName must match struct name", zap.String("parent_name", parentName), zap.String("struct_inherit_type", inheritType.Name()))
+		}
+	case fmt.Sprintf("%sImpl", parentName) != inheritType.Name():
 		log.Panic("GetParentClassName must match struct name", zap.String("parent_name", parentName), zap.String("struct_inherit_type", inheritType.Name()))
 	}
 	cl := NewClassInfo(className, parentName, level, classType, inheritType, parentPtr, propertyList, validateProperty)
 	if cl == nil {
 		log.Panic("ClassInfo cannot be nil")
 	}
+	cl.Options = newClassOptions(opts)
 	Internal.GDRegisteredGDClasses.Set(className, cl)
-	if _, ok := GDNativeConstructors.Get(parentName); !ok {
+	if _, ok := GDNativeConstructors.Get(cl.NativeParentName()); !ok {
 		log.Panic("Missing GDExtensionClass interface: unhandled inherits type", zap.Any("class_type", classType), zap.Any("parent_type", parentName))
 	}
 	Internal.GDClassConstructors.Set(className, constructor)
 	GDRegisteredGDClassEncoders.Set(className, CreateObjectEncoder[T]())
 	GDClassRegisterInstanceBindingCallbacks(className)
 	cName := C.CString(className)
+	var iconPath GDExtensionConstStringPtr
+	if cl.Options.IconPath != "" {
+		gdsIconPath := NewStringWithUtf8Chars(cl.Options.IconPath)
+		defer gdsIconPath.Destroy()
+		iconPath = gdsIconPath.AsGDExtensionConstStringPtr()
+	}
+	// abstract classes are never instantiated by the engine
+	createInstanceFunc := (GDExtensionClassCreateInstance2)(C.cgo_classcreationinfo_createinstance)
+	recreateInstanceFunc := (GDExtensionClassRecreateInstance)(C.cgo_classcreationinfo_recreateinstance)
+	if cl.Options.Abstract {
+		createInstanceFunc = nil
+		recreateInstanceFunc = nil
+	}
 	// Register this class with Godot
-	info := NewGDExtensionClassCreationInfo4(
-		GDExtensionBool(0),
-		GDExtensionBool(0),
-		GDExtensionBool(1),
-		GDExtensionBool(0),
-		(GDExtensionConstStringPtr)(nil),
+	info := NewGDExtensionClassCreationInfo5(
+		(GDExtensionBool)(BoolToUint8(cl.Options.Virtual)),
+		(GDExtensionBool)(BoolToUint8(cl.Options.Abstract)),
+		(GDExtensionBool)(BoolToUint8(cl.Options.Exposed)),
+		(GDExtensionBool)(BoolToUint8(cl.Options.Runtime)),
+		iconPath,
 		(GDExtensionClassSet)(C.cgo_classcreationinfo_set),
 		(GDExtensionClassGet)(C.cgo_classcreationinfo_get),
 		(GDExtensionClassGetPropertyList)(C.cgo_classcreationinfo_getpropertylist),
@@ -488,11 +585,11 @@ func ClassDBRegisterClass[T Object](
 		(GDExtensionClassValidateProperty)(C.cgo_classcreationinfo_validateproperty),
 		(GDExtensionClassNotification2)(C.cgo_classcreationinfo_notification),
 		(GDExtensionClassToString)(C.cgo_classcreationinfo_tostring),
-		(GDExtensionClassReference)(nil),
-		(GDExtensionClassUnreference)(nil),
-		(GDExtensionClassCreateInstance2)(C.cgo_classcreationinfo_createinstance),
+		(GDExtensionClassReference)(C.cgo_classcreationinfo_reference),
+		(GDExtensionClassUnreference)(C.cgo_classcreationinfo_unreference),
+		createInstanceFunc,
 		(GDExtensionClassFreeInstance)(C.cgo_classcreationinfo_freeinstance),
-		(GDExtensionClassRecreateInstance)(nil),
+		recreateInstanceFunc,
 		(GDExtensionClassGetVirtualCallData2)(C.cgo_classcreationinfo_getvirtualcallwithdata2),
 		(GDExtensionClassCallVirtualWithData)(C.cgo_classcreationinfo_callvirtualwithdata),
 		unsafe.Pointer(cName),
@@ -504,9 +601,10 @@ func ClassDBRegisterClass[T Object](
 	log.Info("gdclass registered",
 		zap.String("class", className),
 		zap.String("parent_type", parentName),
+		zap.Any("options", cl.Options),
 	)
 	// register with Godot
-	CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClass4(
+	CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClass5(
 		(GDExtensionClassLibraryPtr)(FFI.Library),
 		snName.AsGDExtensionConstStringNamePtr(),
 		snParentName.AsGDExtensionConstStringNamePtr(),
@@ -514,6 +612,7 @@ func ClassDBRegisterClass[T Object](
 	)
 	// call bindMethodsFunc as a callback for users to register their methods on the class
 	bindMethodsFunc(inst)
+	classDBBindEngineVirtualMethods[T](cl)
 }
 
 func ClassDBUnregisterClass[T Object]() {
@@ -530,6 +629,7 @@ func ClassDBUnregisterClass[T Object]() {
 		return
 	}
 	Internal.GDRegisteredGDClasses.Delete(className)
+	releaseClassInstances(className, reloading())
 	name := NewStringNameWithLatin1Chars(className)
 	defer name.Destroy()
 	CallFunc_GDExtensionInterfaceClassdbUnregisterExtensionClass(
@@ -0,0 +1,700 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/constant"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	. "github.com/godot-go/godot-go/pkg/gdclassinit"
+	"github.com/godot-go/godot-go/pkg/log"
+	. "github.com/godot-go/godot-go/pkg/util"
+	"go.uber.org/zap"
+)
+
+// ClassDBRegisterClassAuto registers T like ClassDBRegisterClass, but the
+// bindings are derived from T instead of being listed by hand:
+//
+//   - every exported method declared on T is bound with a snake_case name
+//     (SimpleFunc becomes simple_func); variadic methods are bound as varargs
+//   - methods prefixed with "V_" are bound as virtual overrides (V_Ready
+//     becomes _ready), except V_Notification which receives notifications
+//     and the StateSerializer, ReferenceNotifier and PropertyLister
+//     methods; overrides of engine virtual methods should implement their
+//     interface, such as NodeReadyVirtual
+//   - struct fields tagged with `godot:"..."` add properties, groups and
+//     signals in field order; exported SignalN fields are signals without a
+//     tag and are bound to every instance for Emit and Connect
+//
+// Methods promoted from the embedded parent class and the Wrapped interface
+// methods are never bound; helper methods should be left unexported. The
+// supported struct tags are:
+//
+//	Speed  int64                    `godot:"property,hint=range,hint_string=0,100,1"`
+//	speed  float64                  `godot:"export,hint=range,hint_string=0,10"`
+//	Moved  func(pos Vector2)        `godot:"signal,args=position"`
+//	Healed Signal1[int64]           `godot:"signal,args=amount"`
+//	_      struct{}                 `godot:"group=Movement,prefix=movement_"`
+//	_      struct{}                 `godot:"subgroup=Limits,prefix=movement_limits_"`
+//	_      struct{}                 `godot:"method=Add,name=add_numbers,args=a;b,static"`
+//
+// Properties use the methods Get<Field> and Set<Field> unless get= or set= name
+// other methods; a missing default setter makes the property read-only. Export
+// fields are read and written directly, see ClassDBAddFieldProperty.
+// Argument names default to arg0, arg1, ... and can be set with a method tag.
+// A hint_string option must come last as it may contain commas.
+//
+// Every binding problem is collected and returned in a single error before
+// anything is registered with Godot. bindMethodsFunc is optional and is called
+// after the derived bindings for anything that cannot be declared on T, such
+// as constants. opts are passed on to ClassDBRegisterClass.
+func ClassDBRegisterClassAuto[T Object](
+	constructor GDClassGoConstructorFromOwner,
+	bindMethodsFunc func(t T),
+	opts ...ClassOption,
+) error {
+	t := reflect.TypeFor[T]()
+	classType := t
+	if classType.Kind() == reflect.Pointer {
+		classType = classType.Elem()
+	}
+	if classType.Kind() != reflect.Struct || classType.NumField() == 0 {
+		return fmt.Errorf("unable to register %s: expected a pointer to a struct embedding its parent class", t)
+	}
+	b, errs := newAutoClassBindings(t, classType)
+	if len(errs) > 0 {
+		return fmt.Errorf("unable to register class %s:\n%w", classType.Name(), errors.Join(errs...))
+	}
+	log.Debug("ClassDBRegisterClassAuto called",
+		zap.String("class", classType.Name()),
+		zap.Int("methods", len(b.methods)),
+		zap.Int("members", len(b.members)),
+	)
+	ClassDBRegisterClass(constructor, nil, nil, func(inst T) {
+		bindAutoClass(inst, b)
+		if bindMethodsFunc != nil {
+			bindMethodsFunc(inst)
+		}
+	}, opts...)
+	return nil
+}
+
+type autoMemberKind uint8
+
+const (
+	autoMemberProperty autoMemberKind = iota
+	autoMemberGroup
+	autoMemberSubgroup
+	autoMemberSignal
+	autoMemberTypedSignal
+	autoMemberFieldProperty
+)
+
+// autoMethod is a method binding derived by ClassDBRegisterClassAuto.
+type autoMethod struct {
+	goName     string
+	gdName     string
+	argNames   []string
+	flags      MethodFlags
+	methodType reflect.Type
+}
+
+// autoMember is a property, group or signal derived from a struct field.
+type autoMember struct {
+	kind        autoMemberKind
+	name        string
+	prefix      string
+	setter      string
+	getter      string
+	variantType GDExtensionVariantType
+	options     []PropertyOption
+	params      []SignalParam
+	field       string
+	argNames    []string
+}
+
+type autoClassBindings struct {
+	methods []autoMethod
+	members []autoMember
+}
+
+func bindAutoClass[T GDClass](inst T, b *autoClassBindings) {
+	for _, m := range b.methods {
+		classDBBindMethod(inst, m.goName, m.gdName, m.flags, m.argNames, nil)
+	}
+	for _, m := range b.members {
+		switch m.kind {
+		case autoMemberGroup:
+			ClassDBAddPropertyGroup(inst, m.name, m.prefix)
+		case autoMemberSubgroup:
+			ClassDBAddPropertySubgroup(inst, m.name, m.prefix)
+		case autoMemberProperty:
+			ClassDBAddProperty(inst, m.variantType, m.name, m.setter, m.getter, m.options...)
+		case autoMemberSignal:
+			ClassDBAddSignal(inst, m.name, m.params...)
+		case autoMemberTypedSignal:
+			ClassDBAddTypedSignal(inst, m.field, m.name, m.argNames...)
+		case autoMemberFieldProperty:
+			ClassDBAddFieldProperty(inst, m.field, m.name, m.options...)
+		}
+	}
+}
+
+// godotTag is a parsed `godot:"..."` struct tag. The first element selects the
+// kind of binding, optionally with a value, and is followed by key=value
+// options.
+type godotTag struct {
+	kind    string
+	value   string
+	options map[string]string
+}
+
+var godotTagOptions = map[string][]string{
+	"property": {"name", "get", "set", "hint", "hint_string", "usage", "class_name"},
+	"export":   {"name", "hint", "hint_string", "usage", "class_name"},
+	"signal":   {"name", "args"},
+	"group":    {"prefix"},
+	"subgroup": {"prefix"},
+	"method":   {"name", "args", "static"},
+}
+
+func parseGodotTag(tag string) (godotTag, error) {
+	parts := strings.Split(tag, ",")
+	kind, value, _ := strings.Cut(parts[0], "=")
+	gt := godotTag{
+		kind:    strings.TrimSpace(kind),
+		value:   value,
+		options: map[string]string{},
+	}
+	allowed, ok := godotTagOptions[gt.kind]
+	if !ok {
+		return gt, fmt.Errorf("unknown godot tag %q", gt.kind)
+	}
+	for i := 1; i < len(parts); i++ {
+		k, v, _ := strings.Cut(parts[i], "=")
+		k = strings.TrimSpace(k)
+		if k == "hint_string" {
+			// the hint string takes the remainder of the tag as it may contain commas
+			v = strings.Join(append([]string{v}, parts[i+1:]...), ",")
+			i = len(parts)
+		}
+		found := false
+		for _, a := range allowed {
+			if a == k {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return gt, fmt.Errorf("unknown option %q for godot tag %q", k, gt.kind)
+		}
+		if _, ok := gt.options[k]; ok {
+			return gt, fmt.Errorf("duplicate option %q in godot tag", k)
+		}
+		gt.options[k] = v
+	}
+	return gt, nil
+}
+
+var autoPropertyHints = map[string]PropertyHint{
+	"none":               PROPERTY_HINT_NONE,
+	"range":              PROPERTY_HINT_RANGE,
+	"enum":               PROPERTY_HINT_ENUM,
+	"enum_suggestion":    PROPERTY_HINT_ENUM_SUGGESTION,
+	"exp_easing":         PROPERTY_HINT_EXP_EASING,
+	"flags":              PROPERTY_HINT_FLAGS,
+	"layers_2d_render":   PROPERTY_HINT_LAYERS_2D_RENDER,
+	"layers_2d_physics":  PROPERTY_HINT_LAYERS_2D_PHYSICS,
+	"layers_3d_render":   PROPERTY_HINT_LAYERS_3D_RENDER,
+	"layers_3d_physics":  PROPERTY_HINT_LAYERS_3D_PHYSICS,
+	"file":               PROPERTY_HINT_FILE,
+	"dir":                PROPERTY_HINT_DIR,
+	"global_file":        PROPERTY_HINT_GLOBAL_FILE,
+	"global_dir":         PROPERTY_HINT_GLOBAL_DIR,
+	"save_file":          PROPERTY_HINT_SAVE_FILE,
+	"resource_type":      PROPERTY_HINT_RESOURCE_TYPE,
+	"multiline_text":     PROPERTY_HINT_MULTILINE_TEXT,
+	"expression":         PROPERTY_HINT_EXPRESSION,
+	"placeholder_text":   PROPERTY_HINT_PLACEHOLDER_TEXT,
+	"color_no_alpha":     PROPERTY_HINT_COLOR_NO_ALPHA,
+	"node_type":          PROPERTY_HINT_NODE_TYPE,
+	"locale_id":          PROPERTY_HINT_LOCALE_ID,
+	"localizable_string": PROPERTY_HINT_LOCALIZABLE_STRING,
+	"password":           PROPERTY_HINT_PASSWORD,
+}
+
+var autoPropertyUsages = map[string]PropertyUsageFlags{
+	"none":      PROPERTY_USAGE_NONE,
+	"default":   PROPERTY_USAGE_DEFAULT,
+	"storage":   PROPERTY_USAGE_STORAGE,
+	"editor":    PROPERTY_USAGE_EDITOR,
+	"no_editor": PROPERTY_USAGE_NO_EDITOR,
+	"internal":  PROPERTY_USAGE_INTERNAL,
+	"read_only": PROPERTY_USAGE_READ_ONLY,
+	"secret":    PROPERTY_USAGE_SECRET,
+}
+
+// parsePropertyUsage parses usage flags joined by "|", e.g. "storage|read_only".
+func parsePropertyUsage(v string) (PropertyUsageFlags, error) {
+	var usage PropertyUsageFlags
+	for _, name := range strings.Split(v, "|") {
+		u, ok := autoPropertyUsages[strings.TrimSpace(name)]
+		if !ok {
+			return 0, fmt.Errorf("unknown property usage %q", name)
+		}
+		usage |= u
+	}
+	return usage, nil
+}
+
+// autoVirtualMethodName converts a "V_" method name into the name of the Godot
+// virtual method it overrides.
+func autoVirtualMethodName(goMethodName string) string {
+	return "_" + SnakeCase(strings.TrimPrefix(goMethodName, "V_"))
+}
+
+// GodotMethodName returns the name ClassDBRegisterClassAuto binds a Go method
+// under: SimpleFunc becomes simple_func and V_Ready becomes _ready.
+func GodotMethodName(goMethodName string) string {
+	if strings.HasPrefix(goMethodName, "V_") {
+		return autoVirtualMethodName(goMethodName)
+	}
+	return SnakeCase(goMethodName)
+}
+
+// autoVariantType is ReflectTypeToGDExtensionVariantType returning an error
+// instead of panicking on unsupported types.
+func autoVariantType(t reflect.Type) (vt GDExtensionVariantType, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("unsupported type %v", t)
+		}
+	}()
+	return ReflectTypeToGDExtensionVariantType(t), nil
+}
+
+func autoArgNames(args string, count int) ([]string, error) {
+	if len(args) == 0 {
+		names := make([]string, count)
+		for i := range names {
+			names[i] = fmt.Sprintf("arg%d", i)
+		}
+		return names, nil
+	}
+	names := strings.Split(args, ";")
+	if len(names) != count {
+		return nil, fmt.Errorf("%d argument names given for %d arguments", len(names), count)
+	}
+	return names, nil
+}
+
+// validateAutoMethodType checks that every argument and return value of a
+// method can be converted to and from a Variant.
+func validateAutoMethodType(mt reflect.Type, receiverCount int) []error {
+	var errs []error
+	for i := receiverCount; i < mt.NumIn(); i++ {
+		at := mt.In(i)
+		if mt.IsVariadic() && i == mt.NumIn()-1 {
+			if at.Elem() != reflect.TypeFor[Variant]() {
+				errs = append(errs, fmt.Errorf("variadic argument must be ...Variant, not %v", at))
+			}
+			continue
+		}
+		if _, err := autoVariantType(at); err != nil {
+			errs = append(errs, fmt.Errorf("argument %d: %w", i-receiverCount, err))
+		}
+	}
+	switch mt.NumOut() {
+	case 0:
+	case 2:
+		if mt.Out(1).Kind() != reflect.Bool && mt.Out(1) != errorType {
+			errs = append(errs, fmt.Errorf("second return value must be bool or error, not %v", mt.Out(1)))
+		}
+		fallthrough
+	case 1:
+		if mt.Out(0) == errorType {
+			break
+		}
+		if _, err := autoVariantType(mt.Out(0)); err != nil {
+			errs = append(errs, fmt.Errorf("return value: %w", err))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("cannot return more than 2 values"))
+	}
+	return errs
+}
+
+func newAutoClassBindings(t reflect.Type, classType reflect.Type) (*autoClassBindings, []error) {
+	var (
+		errs           []error
+		fieldTags      []reflect.StructField
+		methodTags     = map[string]godotTag{}
+		parsedTags     = map[string]godotTag{}
+		b              = &autoClassBindings{}
+		gdNames        = map[string]string{}
+		methodByName   = map[string]autoMethod{}
+		engineVirtuals = map[string]EngineVirtualMethod{}
+	)
+	for _, vm := range engineVirtualMethods(classType) {
+		if _, ok := engineVirtuals[vm.Name]; !ok {
+			engineVirtuals[vm.Name] = vm
+		}
+	}
+	// the embedded parent class must be the first field
+	inheritType := reflect.PointerTo(classType.Field(0).Type)
+	wrappedType := reflect.TypeFor[Wrapped]()
+	for i := 0; i < classType.NumField(); i++ {
+		f := classType.Field(i)
+		tag, ok := f.Tag.Lookup("godot")
+		if !ok && f.IsExported() && reflect.PointerTo(f.Type).Implements(typedSignalType) {
+			// typed signal fields are signals without being tagged
+			tag, ok = "signal", true
+		}
+		if !ok || tag == "-" {
+			continue
+		}
+		gt, err := parseGodotTag(tag)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("field %s: %w", f.Name, err))
+			continue
+		}
+		if gt.kind == "method" {
+			if len(gt.value) == 0 {
+				errs = append(errs, fmt.Errorf("field %s: method tag must name a method", f.Name))
+				continue
+			}
+			if _, ok := methodTags[gt.value]; ok {
+				errs = append(errs, fmt.Errorf("field %s: method %s is tagged more than once", f.Name, gt.value))
+				continue
+			}
+			methodTags[gt.value] = gt
+			continue
+		}
+		parsedTags[f.Name] = gt
+		fieldTags = append(fieldTags, f)
+	}
+	// methods
+	for i := 0; i < t.NumMethod(); i++ {
+		m := t.Method(i)
+		if _, ok := wrappedType.MethodByName(m.Name); ok {
+			continue
+		}
+		if _, ok := inheritType.MethodByName(m.Name); ok {
+			continue
+		}
+		if m.Name == "V_Notification" {
+			// dispatched by the notification callback instead of being bound
+			continue
+		}
+		if _, ok := stateSerializerType.MethodByName(m.Name); ok {
+			// called on hot reload instead of being bound
+			continue
+		}
+		if _, ok := referenceNotifierType.MethodByName(m.Name); ok {
+			// called by the reference callbacks instead of being bound
+			continue
+		}
+		if _, ok := propertyListerType.MethodByName(m.Name); ok {
+			// called by the get_property_list callback instead of being bound
+			continue
+		}
+		gt, tagged := methodTags[m.Name]
+		delete(methodTags, m.Name)
+		am := autoMethod{
+			goName:     m.Name,
+			methodType: m.Type,
+		}
+		isVirtual := strings.HasPrefix(m.Name, "V_")
+		switch {
+		case isVirtual:
+			am.gdName = autoVirtualMethodName(m.Name)
+			am.flags = METHOD_FLAG_VIRTUAL
+		case m.Type.IsVariadic():
+			am.gdName = SnakeCase(m.Name)
+			am.flags = METHOD_FLAG_VARARG
+		default:
+			am.gdName = SnakeCase(m.Name)
+			am.flags = METHOD_FLAGS_DEFAULT
+		}
+		argCount := m.Type.NumIn() - 1
+		if m.Type.IsVariadic() {
+			argCount--
+		}
+		var argNames string
+		if vm, ok := engineVirtuals[am.gdName]; ok && isVirtual {
+			if t.Implements(vm.Type) {
+				argNames = strings.Join(vm.ArgNames, ";")
+			} else {
+				log.Warn("virtual method does not implement the interface of the engine virtual method",
+					zap.String("method", m.Name),
+					zap.Any("interface", vm.Type),
+				)
+			}
+		}
+		if tagged {
+			if name, ok := gt.options["name"]; ok {
+				am.gdName = name
+			}
+			if args, ok := gt.options["args"]; ok {
+				argNames = args
+			}
+			if _, ok := gt.options["static"]; ok {
+				if isVirtual {
+					errs = append(errs, fmt.Errorf("method %s: virtual methods cannot be static", m.Name))
+				}
+				am.flags = (am.flags &^ METHOD_FLAGS_DEFAULT) | METHOD_FLAG_STATIC
+			}
+		}
+		names, err := autoArgNames(argNames, argCount)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("method %s: %w", m.Name, err))
+		}
+		am.argNames = names
+		for _, err := range validateAutoMethodType(m.Type, 1) {
+			errs = append(errs, fmt.Errorf("method %s: %w", m.Name, err))
+		}
+		if other, ok := gdNames[am.gdName]; ok {
+			errs = append(errs, fmt.Errorf("method %s: name %q is already used by %s", m.Name, am.gdName, other))
+		}
+		gdNames[am.gdName] = m.Name
+		methodByName[m.Name] = am
+		b.methods = append(b.methods, am)
+	}
+	for name := range methodTags {
+		errs = append(errs, fmt.Errorf("method %s: tagged method is not an exported method of %s", name, classType.Name()))
+	}
+	// fields in declaration order
+	propertyNames := map[string]struct{}{}
+	for _, f := range fieldTags {
+		gt := parsedTags[f.Name]
+		switch gt.kind {
+		case "group", "subgroup":
+			if len(gt.value) == 0 {
+				errs = append(errs, fmt.Errorf("field %s: %s tag must have a name", f.Name, gt.kind))
+				continue
+			}
+			kind := autoMemberGroup
+			if gt.kind == "subgroup" {
+				kind = autoMemberSubgroup
+			}
+			b.members = append(b.members, autoMember{
+				kind:   kind,
+				name:   gt.value,
+				prefix: gt.options["prefix"],
+			})
+		case "property":
+			m, fieldErrs := newAutoProperty(f, gt, methodByName)
+			for _, err := range fieldErrs {
+				errs = append(errs, fmt.Errorf("property field %s: %w", f.Name, err))
+			}
+			if _, ok := propertyNames[m.name]; ok {
+				errs = append(errs, fmt.Errorf("property field %s: name %q is already used", f.Name, m.name))
+			}
+			propertyNames[m.name] = struct{}{}
+			b.members = append(b.members, m)
+		case "export":
+			m, fieldErrs := newAutoFieldProperty(f, gt)
+			for _, err := range fieldErrs {
+				errs = append(errs, fmt.Errorf("export field %s: %w", f.Name, err))
+			}
+			if _, ok := propertyNames[m.name]; ok {
+				errs = append(errs, fmt.Errorf("export field %s: name %q is already used", f.Name, m.name))
+			}
+			propertyNames[m.name] = struct{}{}
+			getterName, setterName := fieldPropertyMethodNames(m.name)
+			for _, n := range []string{getterName, setterName} {
+				if other, ok := gdNames[n]; ok {
+					errs = append(errs, fmt.Errorf("export field %s: accessor %q is already used by %s", f.Name, n, other))
+				}
+				gdNames[n] = f.Name
+			}
+			b.members = append(b.members, m)
+		case "signal":
+			m, fieldErrs := newAutoSignal(f, gt)
+			for _, err := range fieldErrs {
+				errs = append(errs, fmt.Errorf("signal field %s: %w", f.Name, err))
+			}
+			b.members = append(b.members, m)
+		}
+	}
+	return b, errs
+}
+
+func upperFirst(v string) string {
+	if len(v) == 0 {
+		return v
+	}
+	return strings.ToUpper(v[:1]) + v[1:]
+}
+
+func newAutoProperty(f reflect.StructField, gt godotTag, methodByName map[string]autoMethod) (autoMember, []error) {
+	var errs []error
+	m := autoMember{
+		kind: autoMemberProperty,
+		name: SnakeCase(f.Name),
+	}
+	if name, ok := gt.options["name"]; ok {
+		m.name = name
+	}
+	vt, err := autoVariantType(f.Type)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	m.variantType = vt
+	getterName, ok := gt.options["get"]
+	if !ok {
+		getterName = "Get" + upperFirst(f.Name)
+	}
+	if getter, ok := methodByName[getterName]; !ok {
+		errs = append(errs, fmt.Errorf("getter %s not found", getterName))
+	} else if getter.methodType.NumIn() != 1 || getter.methodType.NumOut() != 1 {
+		errs = append(errs, fmt.Errorf("getter %s must take no arguments and return a single value", getterName))
+	} else {
+		m.getter = getter.gdName
+	}
+	setterName, explicitSetter := gt.options["set"]
+	if !explicitSetter {
+		setterName = "Set" + upperFirst(f.Name)
+	}
+	if setter, ok := methodByName[setterName]; !ok {
+		// properties without a setter are read-only
+		if explicitSetter {
+			errs = append(errs, fmt.Errorf("setter %s not found", setterName))
+		}
+	} else if setter.methodType.NumIn() != 2 {
+		errs = append(errs, fmt.Errorf("setter %s must take a single argument", setterName))
+	} else {
+		m.setter = setter.gdName
+	}
+	options, optionErrs := autoPropertyOptions(gt)
+	m.options = options
+	errs = append(errs, optionErrs...)
+	return m, errs
+}
+
+func newAutoFieldProperty(f reflect.StructField, gt godotTag) (autoMember, []error) {
+	var errs []error
+	m := autoMember{
+		kind:  autoMemberFieldProperty,
+		name:  SnakeCase(f.Name),
+		field: f.Name,
+	}
+	if name, ok := gt.options["name"]; ok {
+		m.name = name
+	}
+	if err := validateFieldPropertyType(f.Type); err != nil {
+		errs = append(errs, err)
+	}
+	options, optionErrs := autoPropertyOptions(gt)
+	m.options = options
+	errs = append(errs, optionErrs...)
+	return m, errs
+}
+
+// autoPropertyOptions converts the hint, hint_string, usage and class_name
+// options of a property tag.
+func autoPropertyOptions(gt godotTag) ([]PropertyOption, []error) {
+	var (
+		options []PropertyOption
+		errs    []error
+	)
+	if v, ok := gt.options["hint"]; ok {
+		hint, ok := autoPropertyHints[v]
+		if !ok {
+			errs = append(errs, fmt.Errorf("unknown property hint %q", v))
+		}
+		options = append(options, WithPropertyHint(hint, gt.options["hint_string"]))
+	} else if _, ok := gt.options["hint_string"]; ok {
+		errs = append(errs, fmt.Errorf("hint_string requires a hint"))
+	}
+	if v, ok := gt.options["usage"]; ok {
+		usage, err := parsePropertyUsage(v)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		options = append(options, WithPropertyUsage(usage))
+	}
+	if v, ok := gt.options["class_name"]; ok {
+		options = append(options, WithPropertyClassName(v))
+	}
+	return options, errs
+}
+
+// FieldPropertyTag parses the `godot:"property,..."` or `godot:"export,..."`
+// tag of a struct field whose value is accessed directly instead of through a
+// getter and setter, such as a field of a script behaviour. ok is false when f
+// has no property tag; the get= and set= options are rejected.
+func FieldPropertyTag(f reflect.StructField) (name string, po PropertyOptions, ok bool, err error) {
+	tag, found := f.Tag.Lookup("godot")
+	if !found || tag == "-" {
+		return "", po, false, nil
+	}
+	gt, err := parseGodotTag(tag)
+	if err != nil {
+		return "", po, false, fmt.Errorf("field %s: %w", f.Name, err)
+	}
+	if gt.kind != "property" && gt.kind != "export" {
+		return "", po, false, nil
+	}
+	errs := []error{}
+	for _, k := range []string{"get", "set"} {
+		if _, ok := gt.options[k]; ok {
+			errs = append(errs, fmt.Errorf("option %q is not supported", k))
+		}
+	}
+	options, optionErrs := autoPropertyOptions(gt)
+	errs = append(errs, optionErrs...)
+	if len(errs) > 0 {
+		return "", po, false, fmt.Errorf("property field %s: %w", f.Name, errors.Join(errs...))
+	}
+	name = SnakeCase(f.Name)
+	if v, ok := gt.options["name"]; ok {
+		name = v
+	}
+	return name, newPropertyOptions(options), true, nil
+}
+
+func newAutoSignal(f reflect.StructField, gt godotTag) (autoMember, []error) {
+	var errs []error
+	m := autoMember{
+		kind: autoMemberSignal,
+		name: SnakeCase(f.Name),
+	}
+	if name, ok := gt.options["name"]; ok {
+		m.name = name
+	}
+	if reflect.PointerTo(f.Type).Implements(typedSignalType) {
+		m.kind = autoMemberTypedSignal
+		m.field = f.Name
+		if !f.IsExported() {
+			errs = append(errs, fmt.Errorf("typed signal must be an exported field of the class"))
+		}
+		if args, ok := gt.options["args"]; ok {
+			m.argNames = strings.Split(args, ";")
+		}
+		if _, err := typedSignalParams(f.Type, m.argNames); err != nil {
+			errs = append(errs, err)
+		}
+		return m, errs
+	}
+	if f.Type.Kind() != reflect.Func || f.Type.NumOut() != 0 || f.Type.IsVariadic() {
+		errs = append(errs, fmt.Errorf("signal must be declared as a func without return values or varargs, not %v", f.Type))
+		return m, errs
+	}
+	names, err := autoArgNames(gt.options["args"], f.Type.NumIn())
+	if err != nil {
+		errs = append(errs, err)
+		return m, errs
+	}
+	for i := 0; i < f.Type.NumIn(); i++ {
+		p, err := signalParam(f.Type.In(i), names[i])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("argument %d: %w", i, err))
+		}
+		m.params = append(m.params, p)
+	}
+	return m, errs
+}
@@ -16,8 +16,11 @@ extern GDExtensionBool GoCallback_ClassCreationInfoGet(GDExtensionClassInstanceP
 extern GDExtensionBool GoCallback_ClassCreationInfoSet(GDExtensionClassInstancePtr p_instance, GDExtensionConstStringNamePtr p_name, GDExtensionConstVariantPtr p_value);
 extern void GoCallback_ClassCreationInfoToString(GDExtensionClassInstancePtr p_instance, GDExtensionBool *r_is_valid, GDExtensionStringPtr p_out);
 extern GDExtensionClassCallVirtual GoCallback_ClassCreationInfoGetVirtual(void *p_userdata, GDExtensionConstStringNamePtr p_name);
+extern void GoCallback_ClassCreationInfoReference(GDExtensionClassInstancePtr p_instance);
+extern void GoCallback_ClassCreationInfoUnreference(GDExtensionClassInstancePtr p_instance);
 extern GDExtensionObjectPtr GoCallback_ClassCreationInfoCreateInstance(void *data);
 extern void GoCallback_ClassCreationInfoFreeInstance(void *data, GDExtensionClassInstancePtr ptr);
+extern GDExtensionClassInstancePtr GoCallback_ClassCreationInfoRecreateInstance(void *data, GDExtensionObjectPtr obj);
 
 void cgo_classcreationinfo_getpropertylist(GDExtensionClassInstancePtr p_instance, uint32_t *r_count) {
     printStacktrace();
@@ -64,6 +67,16 @@ void cgo_classcreationinfo_tostring(GDExtensionClassInstancePtr p_instance, GDEx
     GoCallback_ClassCreationInfoToString(p_instance, r_is_valid, p_out);
 }
 
+void cgo_classcreationinfo_reference(GDExtensionClassInstancePtr p_instance) {
+    printStacktrace();
+    GoCallback_ClassCreationInfoReference(p_instance);
+}
+
+void cgo_classcreationinfo_unreference(GDExtensionClassInstancePtr p_instance) {
+    printStacktrace();
+    GoCallback_ClassCreationInfoUnreference(p_instance);
+}
+
 GDExtensionObjectPtr cgo_classcreationinfo_createinstance(void *data) {
     printStacktrace();
     return GoCallback_ClassCreationInfoCreateInstance(data);
@@ -74,6 +87,11 @@ void cgo_classcreationinfo_freeinstance(void *data, GDExtensionClassInstancePtr
     GoCallback_ClassCreationInfoFreeInstance(data, ptr);
 }
 
+GDExtensionClassInstancePtr cgo_classcreationinfo_recreateinstance(void *data, GDExtensionObjectPtr obj) {
+    printStacktrace();
+    return GoCallback_ClassCreationInfoRecreateInstance(data, obj);
+}
+
 GDExtensionBool cgo_classcreationinfo_get(GDExtensionClassInstancePtr p_instance, GDExtensionConstStringNamePtr p_name, GDExtensionVariantPtr r_ret) {
     printStacktrace();
     return GoCallback_ClassCreationInfoGet(p_instance, p_name, r_ret);
@@ -90,7 +90,7 @@ func GoCallback_ClassCreationInfoCallVirtualWithData(pInstance C.GDExtensionClas
 		log.Warn("class not found", zap.String("className", className))
 		return
 	}
-	m, ok := ci.VirtualMethodMap[methodName]
+	m, ok := ci.LookupVirtualMethod(methodName)
 	if !ok {
 		log.Debug("no virtual method found",
 			zap.String("className", className),
@@ -135,12 +135,62 @@ func GoCallback_ClassCreationInfoFreeInstance(data unsafe.Pointer, ptr C.GDExten
 	)
 	id := CallFunc_GDExtensionInterfaceObjectGetInstanceId((GDExtensionConstObjectPtr)(unsafe.Pointer(inst.GetGodotObjectOwner())))
 	if _, ok := Internal.GDClassInstances.Get(id); !ok {
-		log.Panic("GDClass instance not found to free", zap.Any("id", id))
+		// instances released by releaseClassInstances can still be freed by
+		// the engine before the extension is loaded again
+		if !releasedClassInstances.HasKey(id) {
+			log.Panic("GDClass instance not found to free", zap.Any("id", id))
+		}
+		releasedClassInstances.Delete(id)
 	}
 	Internal.GDClassInstances.Delete(id)
+	// the handle was created in WrappedPostInitialize; the engine does not
+	// use the class instance pointer once the instance is freed
+	cgo.Handle(ptr).Delete()
 	log.Info("GDClass instance freed", zap.Any("id", id))
 }
 
+// GoCallback_ClassCreationInfoReference is registered as a callback when the engine
+// takes a reference to an instance of a RefCounted class.
+//
+//export GoCallback_ClassCreationInfoReference
+func GoCallback_ClassCreationInfoReference(p_instance C.GDExtensionClassInstancePtr) {
+	inst, _ := classInfoFromInstance(p_instance)
+	if n, ok := inst.(ReferenceNotifier); ok {
+		n.V_Reference()
+	}
+}
+
+// GoCallback_ClassCreationInfoUnreference is registered as a callback when the engine
+// drops a reference to an instance of a RefCounted class. The instance is
+// freed through GoCallback_ClassCreationInfoFreeInstance once the count
+// reaches zero.
+//
+//export GoCallback_ClassCreationInfoUnreference
+func GoCallback_ClassCreationInfoUnreference(p_instance C.GDExtensionClassInstancePtr) {
+	inst, _ := classInfoFromInstance(p_instance)
+	if n, ok := inst.(ReferenceNotifier); ok {
+		n.V_Unreference()
+	}
+}
+
+// GoCallback_ClassCreationInfoRecreateInstance is registered as a callback when the extension is
+// reloaded and an existing object needs a new instance of its class.
+//
+//export GoCallback_ClassCreationInfoRecreateInstance
+func GoCallback_ClassCreationInfoRecreateInstance(data unsafe.Pointer, obj C.GDExtensionObjectPtr) C.GDExtensionClassInstancePtr {
+	tn := C.GoString((*C.char)(data))
+	log.Info("GoCallback_ClassCreationInfoRecreateInstance called",
+		zap.String("type_name", tn),
+	)
+	instPtr := recreateGDClassInstance(tn, (GDExtensionObjectPtr)(unsafe.Pointer(obj)))
+	return (C.GDExtensionClassInstancePtr)(unsafe.Pointer(instPtr))
+}
+
+// GoCallback_ClassCreationInfoGetPropertyList returns the property list the
+// class was registered with; instances implementing PropertyLister get a list
+// built for the call, which GoCallback_ClassCreationInfoFreePropertyList2
+// releases.
+//
 //export GoCallback_ClassCreationInfoGetPropertyList
 func GoCallback_ClassCreationInfoGetPropertyList(pInstance C.GDExtensionClassInstancePtr, rCount *C.uint32_t) *C.GDExtensionPropertyInfo {
 	wci := cgo.Handle(pInstance).Value().(*WrappedClassInstance)
@@ -160,41 +210,66 @@ func GoCallback_ClassCreationInfoGetPropertyList(pInstance C.GDExtensionClassIns
 			zap.String("class", className),
 		)
 	}
-	if ci.PropertyList == nil {
+	if lister, ok := wci.Instance.(PropertyLister); ok {
+		l := newPropertyInfoList(ci.PropertyList, lister.V_GetPropertyList())
+		*rCount = (C.uint32_t)(len(l.infos))
+		return (*C.GDExtensionPropertyInfo)(unsafe.Pointer(l.keep()))
+	}
+	if len(ci.PropertyList) == 0 {
 		*rCount = (C.uint32_t)(0)
 		return (*C.GDExtensionPropertyInfo)(nil)
 	}
+	// pinned by NewClassInfo
 	*rCount = (C.uint32_t)(len(ci.PropertyList))
-	ptr := unsafe.SliceData(ci.PropertyList)
-	pnr.Pin(ptr)
-	return (*C.GDExtensionPropertyInfo)(unsafe.Pointer(ptr))
+	return (*C.GDExtensionPropertyInfo)(unsafe.Pointer(unsafe.SliceData(ci.PropertyList)))
 }
 
 //export GoCallback_ClassCreationInfoFreePropertyList2
 func GoCallback_ClassCreationInfoFreePropertyList2(pInstance C.GDExtensionClassInstancePtr, pList *C.GDExtensionPropertyInfo, pCount C.uint32_t) {
-	listSlice := unsafe.Slice(pList, pCount)
-	for i := range listSlice {
-		sn := (*StringName)(listSlice[i].class_name)
-		sn.Destroy()
-		n := (*StringName)(listSlice[i].name)
-		n.Destroy()
-		h := (*String)(listSlice[i].hint_string)
-		if h != nil {
-			h.Destroy()
-		}
-	}
+	freePropertyInfoList((*GDExtensionPropertyInfo)(unsafe.Pointer(pList)))
 }
 
 //export GoCallback_ClassCreationInfoPropertyCanRevert
 func GoCallback_ClassCreationInfoPropertyCanRevert(p_instance C.GDExtensionClassInstancePtr, p_name C.GDExtensionConstStringNamePtr) C.GDExtensionBool {
+	inst, ci := classInfoFromInstance(p_instance)
+	if inst == nil {
+		return 0
+	}
+	if ci.PropertyCanRevert(inst, (*StringName)(p_name)) {
+		return 1
+	}
 	return 0
 }
 
 //export GoCallback_ClassCreationInfoPropertyGetRevert
 func GoCallback_ClassCreationInfoPropertyGetRevert(p_instance C.GDExtensionClassInstancePtr, p_name C.GDExtensionConstStringNamePtr, r_ret C.GDExtensionVariantPtr) C.GDExtensionBool {
+	inst, ci := classInfoFromInstance(p_instance)
+	if inst == nil {
+		return 0
+	}
+	if ci.PropertyGetRevert(inst, (*StringName)(p_name), (GDExtensionVariantPtr)(r_ret)) {
+		return 1
+	}
 	return 0
 }
 
+// classInfoFromInstance resolves the Go instance and its registered class.
+func classInfoFromInstance(p_instance C.GDExtensionClassInstancePtr) (GDClass, *ClassInfo) {
+	wci := cgo.Handle(p_instance).Value().(*WrappedClassInstance)
+	if wci == nil || wci.Instance == nil {
+		return nil, nil
+	}
+	inst := wci.Instance
+	className := inst.GetClassName()
+	ci, ok := Internal.GDRegisteredGDClasses.Get(className)
+	if !ok {
+		log.Panic("invalid registered GDClass",
+			zap.String("class", className),
+		)
+	}
+	return inst, ci
+}
+
 //export GoCallback_ClassCreationInfoValidateProperty
 func GoCallback_ClassCreationInfoValidateProperty(pInstance C.GDExtensionClassInstancePtr, pProperty *C.GDExtensionPropertyInfo) C.GDExtensionBool {
 	wci := cgo.Handle(pInstance).Value().(*WrappedClassInstance)
@@ -222,9 +297,16 @@ func GoCallback_ClassCreationInfoValidateProperty(pInstance C.GDExtensionClassIn
 	return 1
 }
 
+// GoCallback_ClassCreationInfoNotification forwards notifications such as
+// NOTIFICATION_READY and NOTIFICATION_PREDELETE to V_Notification methods.
+//
 //export GoCallback_ClassCreationInfoNotification
 func GoCallback_ClassCreationInfoNotification(p_instance C.GDExtensionClassInstancePtr, p_what C.int32_t, p_reversed C.GDExtensionBool) {
-
+	inst, ci := classInfoFromInstance(p_instance)
+	if inst == nil {
+		return
+	}
+	ci.Notify(inst, int32(p_what), p_reversed != 0)
 }
 
 //export GoCallback_ClassCreationInfoGet
@@ -249,7 +331,7 @@ func GoCallback_ClassCreationInfoGet(pInstance C.GDExtensionClassInstancePtr, pN
 			zap.String("method_name", name),
 		)
 	}
-	mcmi, ok := ci.VirtualMethodMap["_get"]
+	mcmi, ok := ci.LookupVirtualMethod("_get")
 	if !ok {
 		log.Info("no V_Get method registered",
 			zap.String("class", className),
@@ -258,7 +340,7 @@ func GoCallback_ClassCreationInfoGet(pInstance C.GDExtensionClassInstancePtr, pN
 		return 0
 	}
 	args := []reflect.Value{
-		reflect.ValueOf(wci.Instance),
+		mcmi.GoMethodMetadata.receiverValue(wci.Instance),
 		reflect.ValueOf(name),
 	}
 	reflectedRet := mcmi.GoMethodMetadata.Func.Call(args)
@@ -305,7 +387,7 @@ func GoCallback_ClassCreationInfoSet(pInstance C.GDExtensionClassInstancePtr, pN
 			zap.String("class", name),
 		)
 	}
-	mcmi, ok := ci.VirtualMethodMap["_set"]
+	mcmi, ok := ci.LookupVirtualMethod("_set")
 	if !ok {
 		log.Info("no V_Set method registered",
 			zap.String("class", name),
@@ -313,7 +395,7 @@ func GoCallback_ClassCreationInfoSet(pInstance C.GDExtensionClassInstancePtr, pN
 		return 0
 	}
 	args := []reflect.Value{
-		reflect.ValueOf(wci.Instance),
+		mcmi.GoMethodMetadata.receiverValue(wci.Instance),
 		reflect.ValueOf(name),
 		reflect.ValueOf(v),
 	}
@@ -32,12 +32,21 @@ void* cgo_classcreationinfo_getvirtualcallwithdata2(void *p_userdata, GDExtensio
 // callback when godot wants to call a method in go marked as a virtual
 void cgo_classcreationinfo_callvirtualwithdata(GDExtensionClassInstancePtr p_instance, GDExtensionConstStringNamePtr p_name, void *p_userdata, const GDExtensionConstTypePtr *p_args, GDExtensionTypePtr r_ret);
 
+// cgo_classcreationinfo_reference signature should match GDExtensionClassReference
+void cgo_classcreationinfo_reference(GDExtensionClassInstancePtr p_instance);
+
+// cgo_classcreationinfo_unreference signature should match GDExtensionClassUnreference
+void cgo_classcreationinfo_unreference(GDExtensionClassInstancePtr p_instance);
+
 // cgo_classcreationinfo_createinstance signature should match GDExtensionClassCreateInstance
 GDExtensionObjectPtr cgo_classcreationinfo_createinstance(void *data);
 
 // cgo_classcreationinfo_freeinstance signature shuold match GDExtensionClassFreeInstance
 void cgo_classcreationinfo_freeinstance(void *data, GDExtensionClassInstancePtr ptr);
 
+// cgo_classcreationinfo_recreateinstance signature should match GDExtensionClassRecreateInstance
+GDExtensionClassInstancePtr cgo_classcreationinfo_recreateinstance(void *data, GDExtensionObjectPtr obj);
+
 // TODO: implement code to utilize _get _set below
 
 // cgo_classdb_get_func should match GDExtensionClassGet
@@ -0,0 +1,60 @@
+package core
+
+import (
+	"reflect"
+	"strings"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/constant"
+	. "github.com/godot-go/godot-go/pkg/gdclassinit"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// engineVirtualMethods returns the virtual methods of the engine classes
+// classType derives from, nearest class first. The classes are found by
+// walking the embedded parent structs down to the generated engine class
+// implementations.
+func engineVirtualMethods(classType reflect.Type) []EngineVirtualMethod {
+	var ret []EngineVirtualMethod
+	for t := classType; t.Kind() == reflect.Struct && t.NumField() > 0; {
+		f := t.Field(0)
+		if !f.Anonymous {
+			break
+		}
+		t = f.Type
+		if vms, ok := GDEngineVirtualMethods.Get(strings.TrimSuffix(t.Name(), "Impl")); ok {
+			ret = append(ret, vms...)
+		}
+	}
+	return ret
+}
+
+// classDBBindEngineVirtualMethods binds the engine virtual methods T
+// overrides by implementing their interface, such as NodeProcessVirtual,
+// unless bindMethodsFunc already bound them. Methods promoted from a Go
+// parent class are left to the parent.
+func classDBBindEngineVirtualMethods[T Object](ci *ClassInfo) {
+	t := reflect.TypeFor[T]()
+	parentType := reflect.PointerTo(ci.InheritType)
+	for _, vm := range engineVirtualMethods(ci.ClassType) {
+		if !t.Implements(vm.Type) {
+			continue
+		}
+		if _, ok := ci.VirtualMethodMap[vm.Name]; ok {
+			continue
+		}
+		goMethodName := vm.GoMethodName()
+		if _, ok := parentType.MethodByName(goMethodName); ok {
+			continue
+		}
+		m, _ := t.MethodByName(goMethodName)
+		log.Debug("engine virtual method implemented",
+			zap.String("class", ci.Name),
+			zap.String("name", vm.Name),
+			zap.Any("interface", vm.Type),
+		)
+		md := NewGoMethodMetadata(m, ci.Name, vm.Name, goMethodName, vm.ArgNames, nil, METHOD_FLAG_VIRTUAL)
+		classDBRegisterMethod(ci.Name, md)
+	}
+}
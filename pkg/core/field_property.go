@@ -0,0 +1,167 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/constant"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// ClassDBAddFieldProperty adds the property name stored in the field
+// fieldName of the class of t. The field is read and written directly through
+// the get_<name> and set_<name> methods bound for it, so the class does not
+// need a getter and setter of its own. Object fields are registered with
+// their class name and typed containers with the hint of their element types
+// unless opts set them.
+func ClassDBAddFieldProperty(t GDClass, fieldName, name string, opts ...PropertyOption) {
+	className := t.GetClassName()
+	ci, ok := Internal.GDRegisteredGDClasses.Get(className)
+	if !ok {
+		log.Panic("Class doesn't exist.", zap.String("class", className))
+		return
+	}
+	f, ok := ci.ClassType.FieldByName(fieldName)
+	if !ok || len(f.Index) != 1 {
+		log.Panic("property field not found",
+			zap.String("class", className),
+			zap.String("field", fieldName),
+		)
+		return
+	}
+	if err := validateFieldPropertyType(f.Type); err != nil {
+		log.Panic("unable to add field property",
+			zap.String("class", className),
+			zap.String("field", fieldName),
+			zap.Error(err),
+		)
+		return
+	}
+	vt, _ := autoVariantType(f.Type)
+	getterName, setterName := fieldPropertyMethodNames(name)
+	for _, n := range []string{getterName, setterName} {
+		if _, ok := ci.MethodMap[n]; ok {
+			log.Panic("field property accessor conflicts with a bound method",
+				zap.String("class", className),
+				zap.String("field", fieldName),
+				zap.String("method", n),
+			)
+			return
+		}
+	}
+	recvType := reflect.PointerTo(ci.ClassType)
+	getter := reflect.MakeFunc(
+		reflect.FuncOf([]reflect.Type{recvType}, []reflect.Type{f.Type}, false),
+		func(args []reflect.Value) []reflect.Value {
+			v := fieldValue(args[0], f)
+			if isDestroyable(f.Type) {
+				// the caller owns the returned value
+				v = copyFieldValue(v)
+			}
+			return []reflect.Value{v}
+		},
+	)
+	setter := reflect.MakeFunc(
+		reflect.FuncOf([]reflect.Type{recvType, f.Type}, nil, false),
+		func(args []reflect.Value) []reflect.Value {
+			v := fieldValue(args[0], f)
+			if isDestroyable(f.Type) && !v.IsZero() {
+				// the argument is a copy owned by the setter and replaces
+				// the value the field owned
+				v.Addr().Interface().(destroyer).Destroy()
+			}
+			v.Set(args[1])
+			return nil
+		},
+	)
+	classDBRegisterMethod(className, newGoMethodMetadata(
+		getter, recvType, className, getterName, "Get"+upperFirst(fieldName),
+		nil, nil, METHOD_FLAGS_DEFAULT,
+	))
+	classDBRegisterMethod(className, newGoMethodMetadata(
+		setter, recvType, className, setterName, "Set"+upperFirst(fieldName),
+		[]string{"value"}, nil, METHOD_FLAGS_DEFAULT,
+	))
+	if vt == GDEXTENSION_VARIANT_TYPE_OBJECT {
+		if cn := ObjectClassName(f.Type); len(cn) > 0 {
+			opts = append([]PropertyOption{WithPropertyClassName(cn)}, opts...)
+		}
+	}
+	if hint, hintString, ok := reflectTypeHint(vt, f.Type); ok {
+		opts = append([]PropertyOption{WithPropertyHint(hint, hintString)}, opts...)
+	}
+	ClassDBAddProperty(t, vt, name, setterName, getterName, opts...)
+}
+
+// fieldPropertyMethodNames returns the names of the methods bound to read and
+// write a field property.
+func fieldPropertyMethodNames(name string) (getter, setter string) {
+	return "get_" + name, "set_" + name
+}
+
+// fieldValue returns the settable field f of the struct recv points to; the
+// field may be unexported.
+func fieldValue(recv reflect.Value, f reflect.StructField) reflect.Value {
+	v := recv.Elem().FieldByIndex(f.Index)
+	return reflect.NewAt(f.Type, unsafe.Pointer(v.UnsafeAddr())).Elem()
+}
+
+type destroyer interface {
+	Destroy()
+}
+
+var destroyerType = reflect.TypeFor[destroyer]()
+
+// isDestroyable reports whether values of type t hold engine memory that has
+// to be destroyed, like String, Array, Variant and typed containers.
+func isDestroyable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Array, reflect.Struct:
+		return reflect.PointerTo(t).Implements(destroyerType)
+	}
+	return false
+}
+
+// copyFieldValue returns a copy of the engine value v through a Variant. A
+// zero value, such as an Array that was never created, is copied as an empty
+// one.
+func copyFieldValue(v reflect.Value) reflect.Value {
+	var tmp Variant
+	defer tmp.Destroy()
+	vt := ReflectTypeToGDExtensionVariantType(v.Type())
+	switch {
+	case !v.IsZero():
+		GDExtensionVariantPtrFromReflectValue(v, (GDExtensionUninitializedVariantPtr)(tmp.NativePtr()))
+	case vt != GDEXTENSION_VARIANT_TYPE_VARIANT_MAX:
+		var err error
+		if tmp, err = NewVariantConstructed(vt); err != nil {
+			log.Panic("unable to construct field property value",
+				zap.Any("type", v.Type()),
+				zap.Error(err),
+			)
+		}
+	}
+	ret, err := convertVariantToGoTypeReflectValue(tmp, v.Type())
+	if err != nil {
+		log.Panic("unable to copy field property value",
+			zap.Any("type", v.Type()),
+			zap.Error(err),
+		)
+	}
+	return ret
+}
+
+// validateFieldPropertyType checks that a field of type t can be stored from
+// and returned as a Variant. Ref fields are rejected as returning them hands
+// the reference of the field over to the caller.
+func validateFieldPropertyType(t reflect.Type) error {
+	if t.Implements(refType) {
+		return fmt.Errorf("ref type %v must be exposed with a getter and setter", t)
+	}
+	_, err := autoVariantType(t)
+	return err
+}
@@ -33,6 +33,10 @@ func _GDExtensionBindingInit(
 
 	FFI.LoadProcAddresses(pGetProcAddress, pLibrary)
 
+	// the library is loaded from the engine's main thread
+	RecordMainThread()
+	registerMainLoopCallbacks()
+
 	// Load the Godot version.
 	CallFunc_GDExtensionInterfaceGetGodotVersion(FFI.GodotVersion)
 
@@ -51,6 +55,7 @@ func _GDExtensionBindingInit(
 	for i := range GDEXTENSION_MAX_INITIALIZATION_LEVEL {
 		if GDExtensionBindingInitCallbacks[i] != nil {
 			rInitialization.SetInitializationLevel(i)
+			minimumInitializationLevel = i
 			hasInit = true
 			break
 		}
@@ -84,6 +89,11 @@ func GDExtensionBindingDeinitializeLevel(userdata unsafe.Pointer, pLevel C.GDExt
 	if GDExtensionBindingTerminateCallbacks[pLevel] != nil {
 		GDExtensionBindingTerminateCallbacks[pLevel]()
 	}
+
+	// the extension is fully deinitialized, possibly to be reloaded
+	if (GDExtensionInitializationLevel)(pLevel) == minimumInitializationLevel {
+		releaseInternal()
+	}
 }
 
 type InitObject struct {
@@ -0,0 +1,162 @@
+package core
+
+import (
+	"reflect"
+	"runtime/cgo"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	. "github.com/godot-go/godot-go/pkg/gdclassinit"
+	"github.com/godot-go/godot-go/pkg/log"
+	. "github.com/godot-go/godot-go/pkg/util"
+	"go.uber.org/zap"
+)
+
+// StateSerializer is implemented by classes that keep Go state across hot
+// reloads of the extension. Properties with PROPERTY_USAGE_STORAGE are saved
+// and restored by the engine; anything else has to be carried over by these
+// methods.
+//
+// V_SerializeState is called when the class is unregistered while instances
+// are still alive. After the extension is loaded again, a new Go instance is
+// bound to each engine object and V_RestoreState is called with the
+// Dictionary before the engine restores the stored properties.
+type StateSerializer interface {
+	V_SerializeState() Dictionary
+	V_RestoreState(state Dictionary)
+}
+
+var stateSerializerType = reflect.TypeFor[StateSerializer]()
+
+// hotReloadStateMeta is the metadata the serialized state is kept in while
+// the extension is reloaded; metadata lives on the engine object, so the
+// state survives the library being unloaded.
+const hotReloadStateMeta = "_godot_go_hot_reload_state"
+
+// releasedClassInstances holds the IDs of the instances forgotten by
+// releaseClassInstances until they are recreated or freed.
+var releasedClassInstances = NewSyncMap[GDObjectInstanceID, struct{}]()
+
+// reloading reports whether classes are being unregistered for a hot
+// reload. The editor reloads the extension while the main loop runs,
+// whereas on exit the main loop has shut down before the extension is
+// deinitialized.
+func reloading() bool {
+	return mainQueue.available.Load()
+}
+
+// releaseClassInstances forgets the live instances of the class named
+// className as their engine objects are about to lose their Go instance.
+// The state of instances implementing StateSerializer is saved first when
+// saveState is set.
+func releaseClassInstances(className string, saveState bool) []GDObjectInstanceID {
+	var released []GDObjectInstanceID
+	for _, id := range Internal.GDClassInstances.Keys() {
+		inst, ok := Internal.GDClassInstances.Get(id)
+		if !ok || inst.GetClassName() != className {
+			continue
+		}
+		if s, ok := inst.(StateSerializer); ok && saveState {
+			saveInstanceState(inst, s)
+		}
+		Internal.GDClassInstances.Delete(id)
+		releasedClassInstances.Set(id, struct{}{})
+		released = append(released, id)
+	}
+	return released
+}
+
+// ReloadClassInstances does to the live instances of the Go class T what a
+// hot reload of the extension does: their state is saved, their Go
+// instances are released, and new Go instances are bound to the engine
+// objects and restored. It lets tests check a StateSerializer without
+// rebuilding the extension; the class stays registered and, unlike a real
+// reload, stored properties are not restored by the engine.
+func ReloadClassInstances[T GDClass]() {
+	var zero T
+	className := zero.GetClassName()
+	if _, ok := Internal.GDRegisteredGDClasses.Get(className); !ok {
+		log.Panic("Class doesn't exist.", zap.String("class", className))
+	}
+	for _, id := range releaseClassInstances(className, true) {
+		obj := CallFunc_GDExtensionInterfaceObjectGetInstanceFromId(id)
+		// the engine clears the binding of the old instance on a reload
+		oldHandle := cgo.Handle(uintptr(CallFunc_GDExtensionInterfaceObjectGetInstanceBinding(obj, FFI.Token, nil)))
+		CallFunc_GDExtensionInterfaceObjectFreeInstanceBinding(obj, FFI.Token)
+		recreateGDClassInstance(className, obj)
+		oldHandle.Delete()
+	}
+}
+
+func saveInstanceState(inst GDClass, s StateSerializer) {
+	obj, ok := inst.(Object)
+	if !ok {
+		return
+	}
+	state := s.V_SerializeState()
+	defer state.Destroy()
+	v := NewVariantDictionary(state)
+	defer v.Destroy()
+	name := NewStringNameWithLatin1Chars(hotReloadStateMeta)
+	defer name.Destroy()
+	obj.SetMeta(name, v)
+	log.Debug("instance state saved for hot reload",
+		zap.String("class", inst.GetClassName()),
+	)
+}
+
+// restoreInstanceState removes the state saved before a reload from the
+// engine object and passes it to V_RestoreState; the state is dropped when
+// the reloaded class no longer implements StateSerializer.
+func restoreInstanceState(inst GDClass) {
+	obj, ok := inst.(Object)
+	if !ok {
+		return
+	}
+	name := NewStringNameWithLatin1Chars(hotReloadStateMeta)
+	defer name.Destroy()
+	if !obj.HasMeta(name) {
+		return
+	}
+	nilDefault := NewVariantNil()
+	defer nilDefault.Destroy()
+	v := obj.GetMeta(name, nilDefault)
+	defer v.Destroy()
+	obj.RemoveMeta(name)
+	s, ok := inst.(StateSerializer)
+	if !ok {
+		return
+	}
+	state := v.ToDictionary()
+	defer state.Destroy()
+	s.V_RestoreState(state)
+	log.Debug("instance state restored after hot reload",
+		zap.String("class", inst.GetClassName()),
+	)
+}
+
+// recreateGDClassInstance binds a new Go instance of class tn to an engine
+// object that outlived a reload of the extension.
+func recreateGDClassInstance(tn string, obj GDExtensionObjectPtr) GDExtensionClassInstancePtr {
+	ci, ok := Internal.GDRegisteredGDClasses.Get(tn)
+	if !ok {
+		log.Panic("type not found",
+			zap.String("name", tn),
+		)
+	}
+	releasedClassInstances.Delete(CallFunc_GDExtensionInterfaceObjectGetInstanceId((GDExtensionConstObjectPtr)(obj)))
+	inst, instPtr := bindGDClassInstance(tn, ci, (*GodotObject)(unsafe.Pointer(obj)))
+	restoreInstanceState(inst)
+	return instPtr
+}
+
+// releaseInternal clears the registries once the extension is fully
+// deinitialized. A Go library is never unloaded from the process, so the
+// next initialization after a reload must start from empty registries.
+func releaseInternal() {
+	Internal.GDClassInstances.Clear()
+	Internal.GDRegisteredGDClasses.Clear()
+	Internal.GDClassConstructors.Clear()
+	GDRegisteredGDClassEncoders.Clear()
+}
@@ -41,11 +41,16 @@ func CreateGDClassInstance(tn string) GDClass {
 			zap.String("name", tn),
 		)
 	}
+	if ci.Options.Abstract {
+		log.Panic("abstract class cannot be instantiated",
+			zap.String("name", tn),
+		)
+	}
 	log.Debug("CreateGDClassInstance called",
 		zap.String("class_name", tn),
 		zap.Any("parent_name", ci.ParentName),
 	)
-	snParentName := NewStringNameWithLatin1Chars(ci.ParentName)
+	snParentName := NewStringNameWithLatin1Chars(ci.NativeParentName())
 	defer snParentName.Destroy()
 	// create inherited GDExtensionClass first
 	owner := CallFunc_GDExtensionInterfaceClassdbConstructObject(
@@ -54,16 +59,25 @@ func CreateGDClassInstance(tn string) GDClass {
 	if owner == nil {
 		log.Panic("owner is nil", zap.String("type_name", tn))
 	}
-	// create GDClass
+	inst, _ := bindGDClassInstance(tn, ci, (*GodotObject)(owner))
+	return inst
+}
+
+// bindGDClassInstance creates the Go instance of class tn and binds it to the
+// engine object owner; it returns the instance and the class instance pointer
+// handed to the engine.
+func bindGDClassInstance(tn string, ci *ClassInfo, owner *GodotObject) (GDClass, GDExtensionClassInstancePtr) {
 	reflectedInst := reflect.New(ci.ClassType)
 	inst, ok := reflectedInst.Interface().(GDClass)
 	if !ok {
 		log.Panic("instance not a GDClass", zap.String("type_name", tn))
 	}
-	object := (*GodotObject)(owner)
 	id := CallFunc_GDExtensionInterfaceObjectGetInstanceId((GDExtensionConstObjectPtr)(unsafe.Pointer(owner)))
-	inst.SetGodotObjectOwner(object)
-	WrappedPostInitialize(tn, inst)
+	inst.SetGodotObjectOwner(owner)
+	if obj, ok := inst.(Object); ok {
+		bindSignalFields(ci, reflectedInst, obj)
+	}
+	instPtr := WrappedPostInitialize(tn, inst)
 	Internal.GDClassInstances.Set(id, inst)
 	log.Info("GDClass instance created",
 		zap.Any("object_id", id),
@@ -71,8 +85,7 @@ func CreateGDClassInstance(tn string) GDClass {
 		zap.Any("parent_name", ci.ParentName),
 		zap.String("inst", fmt.Sprintf("%p", inst)),
 		zap.String("owner", fmt.Sprintf("%p", owner)),
-		zap.String("object", fmt.Sprintf("%p", object)),
 		zap.String("inst.GetGodotObjectOwner", fmt.Sprintf("%p", inst.GetGodotObjectOwner())),
 	)
-	return inst
+	return inst, instPtr
 }
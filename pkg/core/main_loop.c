@@ -0,0 +1,22 @@
+#include <godot/gdextension_interface.h>
+#include "main_loop.h"
+#include "stacktrace.h"
+
+extern void GoCallback_MainLoopStartup();
+extern void GoCallback_MainLoopShutdown();
+extern void GoCallback_MainLoopFrame();
+
+void cgo_main_loop_startup_callback() {
+	printStacktrace();
+	GoCallback_MainLoopStartup();
+}
+
+void cgo_main_loop_shutdown_callback() {
+	printStacktrace();
+	GoCallback_MainLoopShutdown();
+}
+
+// called every frame, so no stacktrace is printed
+void cgo_main_loop_frame_callback() {
+	GoCallback_MainLoopFrame();
+}
@@ -0,0 +1,10 @@
+#ifndef CGO_GODOT_GO_MAIN_LOOP_H
+#define CGO_GODOT_GO_MAIN_LOOP_H
+
+#include <godot/gdextension_interface.h>
+
+void cgo_main_loop_startup_callback();
+void cgo_main_loop_shutdown_callback();
+void cgo_main_loop_frame_callback();
+
+#endif
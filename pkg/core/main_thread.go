@@ -0,0 +1,143 @@
+package core
+
+// #include <godot/gdextension_interface.h>
+// #include "main_loop.h"
+import "C"
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// mainThreadQueue holds the funcs queued by RunOnMain until the next frame.
+type mainThreadQueue struct {
+	mu    sync.Mutex
+	funcs []func()
+	// available is true while queued funcs will be drained: after the main
+	// loop callbacks are registered and until the main loop shuts down
+	available atomic.Bool
+}
+
+var mainQueue mainThreadQueue
+
+func (q *mainThreadQueue) push(fn func()) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.available.Load() {
+		return false
+	}
+	q.funcs = append(q.funcs, fn)
+	return true
+}
+
+// drain runs the queued funcs. Funcs queued while draining run on the next
+// frame.
+func (q *mainThreadQueue) drain() {
+	q.mu.Lock()
+	funcs := q.funcs
+	q.funcs = nil
+	q.mu.Unlock()
+	for _, fn := range funcs {
+		runQueuedFunc(fn)
+	}
+}
+
+func runQueuedFunc(fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Error("func queued on the main thread panicked",
+				zap.String("panic", fmt.Sprint(r)),
+			)
+		}
+	}()
+	fn()
+}
+
+// registerMainLoopCallbacks hooks the queue into the engine's main loop.
+// Engines without register_main_loop_callbacks cannot drain the queue.
+func registerMainLoopCallbacks() {
+	if FFI.RegisterMainLoopCallbacks == nil {
+		log.Warn("register_main_loop_callbacks is unavailable; RunOnMain is disabled")
+		return
+	}
+	callbacks := NewGDExtensionMainLoopCallbacks(
+		(GDExtensionMainLoopStartupCallback)(C.cgo_main_loop_startup_callback),
+		(GDExtensionMainLoopShutdownCallback)(C.cgo_main_loop_shutdown_callback),
+		(GDExtensionMainLoopFrameCallback)(C.cgo_main_loop_frame_callback),
+	)
+	CallFunc_GDExtensionInterfaceRegisterMainLoopCallbacks(FFI.Library, &callbacks)
+	mainQueue.available.Store(true)
+}
+
+// RunOnMain queues fn to run on the engine's main thread during the next
+// frame. Engine objects are not thread-safe, so goroutines should use it for
+// every engine call. fn is queued even when RunOnMain is called on the main
+// thread, like call_deferred.
+func RunOnMain(fn func()) {
+	if !mainQueue.push(fn) {
+		log.Warn("main loop is not running; dropping func queued by RunOnMain")
+	}
+}
+
+// ErrMainLoopStopped is returned by RunOnMainWait when the main loop is not
+// running, so a queued func would never run.
+var ErrMainLoopStopped = fmt.Errorf("main loop is not running")
+
+// RunOnMainWait runs fn on the engine's main thread and returns its result.
+// fn runs immediately when RunOnMainWait is called on the main thread;
+// otherwise the caller blocks until the next frame runs fn. A panic in fn is
+// raised again in the caller. ErrMainLoopStopped is returned without running
+// fn when the main loop is not running, such as after it has shut down.
+func RunOnMainWait[T any](fn func() T) (T, error) {
+	if IsMainThread() {
+		return fn(), nil
+	}
+	var (
+		ret       T
+		recovered any
+		done      = make(chan struct{})
+	)
+	ok := mainQueue.push(func() {
+		defer close(done)
+		defer func() {
+			recovered = recover()
+		}()
+		ret = fn()
+	})
+	if !ok {
+		return ret, ErrMainLoopStopped
+	}
+	<-done
+	if recovered != nil {
+		panic(recovered)
+	}
+	return ret, nil
+}
+
+//export GoCallback_MainLoopStartup
+func GoCallback_MainLoopStartup() {
+	RecordMainThread()
+}
+
+//export GoCallback_MainLoopShutdown
+func GoCallback_MainLoopShutdown() {
+	mainQueue.drain()
+	mainQueue.mu.Lock()
+	mainQueue.available.Store(false)
+	funcs := mainQueue.funcs
+	mainQueue.funcs = nil
+	mainQueue.mu.Unlock()
+	for _, fn := range funcs {
+		runQueuedFunc(fn)
+	}
+}
+
+//export GoCallback_MainLoopFrame
+func GoCallback_MainLoopFrame() {
+	mainQueue.drain()
+}
@@ -25,8 +25,8 @@ const (
 	NoneReturnStyle ReturnStyle = iota
 	ValueReturnStyle
 	ValueAndBoolReturnStyle
-	// ValueAndErrorReturnStyle
-	// ErrorReturnStyle
+	ValueAndErrorReturnStyle
+	ErrorReturnStyle
 )
 
 // GoMethodMetadata is used as method_userdata in callbacks called from godot into Go.
@@ -41,13 +41,16 @@ type GoMethodMetadata struct {
 	DefaultArguments       []Variant
 	IsVariadic             bool
 	IsVirtual              bool
+	IsStatic               bool
 	MethodFlags            MethodFlags
+	receiverType           reflect.Type
 	gdeReturnType          GDExtensionVariantType
 	gdeReturnPropertyInfo  GDExtensionPropertyInfo
 	gdeArgumentsInfo       []GDExtensionPropertyInfo
 	gdeArgumentsMetadata   []GDExtensionClassMethodArgumentMetadata
 	gdeArgumentTypes       []GDExtensionVariantType
 	gdeDefaultArgumentPtrs []GDExtensionVariantPtr
+	trampoline             *MethodTrampoline
 }
 
 func NewGoMethodMetadata(
@@ -60,7 +63,6 @@ func NewGoMethodMetadata(
 	methodFlags MethodFlags,
 ) *GoMethodMetadata {
 	mt := method.Type
-	fn := method.Func
 	recv := mt.In(0)
 	if recv.Kind() == reflect.Pointer {
 		recv = recv.Elem()
@@ -72,6 +74,54 @@ func NewGoMethodMetadata(
 			zap.String("reciover", recv.Name()),
 		)
 	}
+	return newGoMethodMetadata(method.Func, mt.In(0), className, gdMethodName, goMethodName, argumentNames, defaultArguments, methodFlags)
+}
+
+// NewGoStaticFuncMetadata creates the metadata for a plain Go function bound
+// as a static method; unlike NewGoMethodMetadata, there is no receiver.
+func NewGoStaticFuncMetadata(
+	fn reflect.Value,
+	className string,
+	gdMethodName string,
+	goMethodName string,
+	argumentNames []string,
+	defaultArguments []Variant,
+	methodFlags MethodFlags,
+) *GoMethodMetadata {
+	if fn.Kind() != reflect.Func {
+		log.Panic("static method must be a function",
+			zap.String("class", className),
+			zap.String("method", gdMethodName),
+			zap.Any("type", fn.Type()),
+		)
+	}
+	return newGoMethodMetadata(fn, nil, className, gdMethodName, goMethodName, argumentNames, defaultArguments, methodFlags)
+}
+
+// newGoMethodMetadata builds the metadata for fn. When receiverType is not
+// nil, the first parameter of fn is the receiver and is not exposed to Godot.
+func newGoMethodMetadata(
+	fn reflect.Value,
+	receiverType reflect.Type,
+	className string,
+	gdMethodName string,
+	goMethodName string,
+	argumentNames []string,
+	defaultArguments []Variant,
+	methodFlags MethodFlags,
+) *GoMethodMetadata {
+	mt := fn.Type()
+	receiverCount := 0
+	if receiverType != nil {
+		receiverCount = 1
+	}
+	isStatic := (methodFlags & METHOD_FLAG_STATIC) == METHOD_FLAG_STATIC
+	if receiverType == nil && !isStatic {
+		log.Panic("only static methods can be bound without a receiver",
+			zap.String("class", className),
+			zap.String("method", gdMethodName),
+		)
+	}
 	isVariadicTyped := mt.IsVariadic()
 	isVariadicFlaged := (methodFlags & METHOD_FLAG_VARARG) == METHOD_FLAG_VARARG
 	isVirtual := (methodFlags & METHOD_FLAG_VIRTUAL) == METHOD_FLAG_VIRTUAL
@@ -96,14 +146,23 @@ func NewGoMethodMetadata(
 	switch returnCount {
 	case 0:
 	case 1:
-		// HACK: the second return value is ignored
-		goReturnType = mt.Out(0)
-		returnStyle = ValueReturnStyle
+		if mt.Out(0) == errorType {
+			// GDScript receives the Error code of the returned error
+			goReturnType = reflect.TypeFor[Error]()
+			returnStyle = ErrorReturnStyle
+		} else {
+			goReturnType = mt.Out(0)
+			returnStyle = ValueReturnStyle
+		}
 	case 2:
 		goReturnType = mt.Out(0)
-		returnStyle = ValueAndBoolReturnStyle
-		if mt.Out(1).Kind() != reflect.Bool {
-			log.Panic("method 2nd return value must be of type bool",
+		switch {
+		case mt.Out(1).Kind() == reflect.Bool:
+			returnStyle = ValueAndBoolReturnStyle
+		case mt.Out(1) == errorType:
+			returnStyle = ValueAndErrorReturnStyle
+		default:
+			log.Panic("method 2nd return value must be of type bool or error",
 				zap.String("method", gdMethodName),
 			)
 		}
@@ -119,9 +178,9 @@ func NewGoMethodMetadata(
 	)
 	returnType := ReflectTypeToGDExtensionVariantType(goReturnType)
 	if returnType != GDEXTENSION_VARIANT_TYPE_NIL {
-		returnPropertyInfo = NewSimpleGDExtensionPropertyInfo(className, returnType, goReturnType.Name())
+		returnPropertyInfo = newReflectTypePropertyInfo(className, returnType, goReturnType)
 	}
-	argumentCount := mt.NumIn() - 1
+	argumentCount := mt.NumIn() - receiverCount
 	if len(argumentNames) > argumentCount {
 		log.Panic(`Method definition has more arguments than the actual method.`,
 			zap.String("method", gdMethodName),
@@ -137,10 +196,10 @@ func NewGoMethodMetadata(
 	argumentsInfo := make([]GDExtensionPropertyInfo, argumentCount)
 	argumentsMetadata := make([]GDExtensionClassMethodArgumentMetadata, argumentCount)
 	for i := 0; i < argumentCount; i++ {
-		t := mt.In(i + 1)
+		t := mt.In(i + receiverCount)
 		goArgumentTypes[i] = t
 		variantTypes[i] = ReflectTypeToGDExtensionVariantType(t)
-		argumentsInfo[i] = NewSimpleGDExtensionPropertyInfo(className, variantTypes[i], t.Name())
+		argumentsInfo[i] = newReflectTypePropertyInfo(className, variantTypes[i], t)
 		argumentsMetadata[i] = GDEXTENSION_METHOD_ARGUMENT_METADATA_NONE
 	}
 	ret := &GoMethodMetadata{
@@ -154,7 +213,9 @@ func NewGoMethodMetadata(
 		DefaultArguments:       defaultArguments,
 		IsVariadic:             isVariadicFlaged,
 		IsVirtual:              isVirtual,
+		IsStatic:               isStatic,
 		MethodFlags:            methodFlags,
+		receiverType:           receiverType,
 		gdeReturnType:          returnType,
 		gdeReturnPropertyInfo:  returnPropertyInfo,
 		gdeArgumentsInfo:       argumentsInfo,
@@ -162,6 +223,7 @@ func NewGoMethodMetadata(
 		gdeArgumentTypes:       variantTypes,
 		gdeDefaultArgumentPtrs: defaultArgumentPtrs,
 	}
+	ret.trampoline = lookupMethodTrampoline(ret)
 	pnr.Pin(&returnPropertyInfo)
 	pnr.Pin(ret)
 	return ret
@@ -170,8 +232,10 @@ func NewGoMethodMetadata(
 // VarargCallFunc is the function signature that can be called from GDScript
 type VarargCallFunc func(GDClass, ...Variant) Variant
 
-// Call is called by GDScript to call into Go
-func (md *GoMethodMetadata) Call(inst GDClass, gdArgs ...Variant) Variant {
+// Call is called by GDScript to call into Go. The returned error is the
+// non-nil error returned by a method bound with an error return value; it has
+// already been reported to Godot as a script error.
+func (md *GoMethodMetadata) Call(inst GDClass, gdArgs ...Variant) (Variant, error) {
 	gdArgsCount := len(gdArgs)
 	defArgsCount := len(md.gdeDefaultArgumentPtrs)
 	callArgs := make([]Variant, len(md.gdeArgumentTypes))
@@ -190,10 +254,10 @@ func (md *GoMethodMetadata) Call(inst GDClass, gdArgs ...Variant) Variant {
 	}
 	exepctedTypes := md.GoArgumentTypes
 	if md.IsVariadic {
-		args := []reflect.Value{
+		args := md.bindReceiver([]reflect.Value{
 			reflect.ValueOf(inst),
 			reflect.ValueOf(gdArgs),
-		}
+		})
 		ret := md.Func.CallSlice(args)
 		log.Info("Call Variadic",
 			zap.String("bind", md.String()),
@@ -201,25 +265,13 @@ func (md *GoMethodMetadata) Call(inst GDClass, gdArgs ...Variant) Variant {
 			zap.String("resolved_args", VariantSliceToString(callArgs)),
 			zap.String("ret", util.ReflectValueSliceToString(ret)),
 		)
-		switch md.GoReturnStyle {
-		case NoneReturnStyle:
-		case ValueAndBoolReturnStyle:
-			log.Warn("second return value ignored")
-			fallthrough
-		case ValueReturnStyle:
-			// return ret[0].Interface().(Variant)
-			v := Variant{}
-			ptr := (GDExtensionUninitializedVariantPtr)(unsafe.Pointer(v.NativePtr()))
-			GDExtensionVariantPtrFromReflectValue(ret[0], ptr)
-			return v
-		default:
-			log.Panic("unexpected MethodBindReturnStyle",
-				zap.Any("value", ret),
-			)
-		}
-		return NewVariantNil()
+		return md.variantFromReturnValues(ret)
+	} else if md.useTrampoline(inst) {
+		var ret Variant
+		md.trampoline.Call(inst, callArgs, (GDExtensionUninitializedVariantPtr)(ret.NativePtr()))
+		return ret, nil
 	} else {
-		args := reflectFuncCallArgsFromGDExtensionConstVariantPtrSliceArgs(inst, callArgs, exepctedTypes)
+		args := md.bindReceiver(reflectFuncCallArgsFromGDExtensionConstVariantPtrSliceArgs(inst, callArgs, exepctedTypes))
 		log.Debug("Calling",
 			zap.String("bind", md.String()),
 			zap.String("gd_args", VariantSliceToString(gdArgs)),
@@ -232,30 +284,53 @@ func (md *GoMethodMetadata) Call(inst GDClass, gdArgs ...Variant) Variant {
 			zap.String("resolved_args", VariantSliceToString(callArgs)),
 			zap.String("ret", util.ReflectValueSliceToString(ret)),
 		)
-		switch md.GoReturnStyle {
-		case NoneReturnStyle:
-		case ValueAndBoolReturnStyle:
-			log.Warn("second return value ignored")
-			fallthrough
-		case ValueReturnStyle:
-			v := Variant{}
-			ptr := (GDExtensionUninitializedVariantPtr)(unsafe.Pointer(v.NativePtr()))
-			pnr.Pin(ptr)
-			GDExtensionVariantPtrFromReflectValue(ret[0], ptr)
-			return v
-		default:
-			log.Panic("unexpected MethodBindReturnStyle",
-				zap.Any("value", ret),
-			)
+		return md.variantFromReturnValues(ret)
+	}
+}
+
+// variantFromReturnValues converts the values returned by a varcall into a
+// Variant according to the method's ReturnStyle.
+func (md *GoMethodMetadata) variantFromReturnValues(ret []reflect.Value) (Variant, error) {
+	var err error
+	switch md.GoReturnStyle {
+	case NoneReturnStyle:
+		return NewVariantNil(), nil
+	case ErrorReturnStyle:
+		err = returnedError(ret[0])
+		if err != nil {
+			md.reportError(err)
+		}
+		return NewVariantInt64(int64(errorCode(err))), err
+	case ValueAndErrorReturnStyle:
+		// the caller fails the call, so no value is returned
+		err = returnedError(ret[1])
+		if err != nil {
+			md.reportError(err)
+			return NewVariantNil(), err
 		}
+	case ValueAndBoolReturnStyle:
+		log.Warn("second return value ignored")
+	case ValueReturnStyle:
+	default:
+		log.Panic("unexpected MethodBindReturnStyle",
+			zap.Any("value", ret),
+		)
 	}
-	return NewVariantNil()
+	v := Variant{}
+	ptr := (GDExtensionUninitializedVariantPtr)(unsafe.Pointer(v.NativePtr()))
+	pnr.Pin(ptr)
+	GDExtensionVariantPtrFromReflectValue(ret[0], ptr)
+	return v, err
 }
 
 // Ptrcall is called by GDScript to call into Go
 func (md *GoMethodMetadata) Ptrcall(inst GDClass, gdArgs []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+	if md.useTrampoline(inst) {
+		md.trampoline.Ptrcall(inst, gdArgs, rReturn)
+		return
+	}
 	exepctedArgTypes := md.GoArgumentTypes
-	args := reflectFuncCallArgsFromGDExtensionConstTypePtrSliceArgs(inst, gdArgs, exepctedArgTypes)
+	args := md.bindReceiver(reflectFuncCallArgsFromGDExtensionConstTypePtrSliceArgs(inst, gdArgs, exepctedArgTypes))
 	ret := md.Func.Call(args)
 	log.Info("Ptrcall",
 		zap.String("bind", md.String()),
@@ -268,6 +343,21 @@ func (md *GoMethodMetadata) Ptrcall(inst GDClass, gdArgs []GDExtensionConstTypeP
 	}
 	switch md.GoReturnStyle {
 	case NoneReturnStyle:
+	case ErrorReturnStyle:
+		err := returnedError(ret[0])
+		if err != nil {
+			md.reportError(err)
+		}
+		Int64Encoder.EncodeTypePtrArg(int64(errorCode(err)), rReturn)
+	case ValueAndErrorReturnStyle:
+		// ptrcalls have no way to signal a call error, so the zero value of
+		// the return type is passed on after the error is reported
+		if err := returnedError(ret[1]); err != nil {
+			md.reportError(err)
+			encodeZeroTypePtr(md.GoReturnType, rReturn)
+			return
+		}
+		GDExtensionTypePtrFromReflectValue(ret[0], rReturn)
 	case ValueAndBoolReturnStyle:
 		log.Warn("second return value ignored")
 		fallthrough
@@ -280,6 +370,59 @@ func (md *GoMethodMetadata) Ptrcall(inst GDClass, gdArgs []GDExtensionConstTypeP
 	}
 }
 
+// useTrampoline reports whether the generated trampoline can call the method
+// on inst; instances of classes deriving from the receiver go through
+// reflection.
+func (md *GoMethodMetadata) useTrampoline(inst GDClass) bool {
+	return md.trampoline != nil && reflect.TypeOf(inst) == md.receiverType
+}
+
+// returnedError unwraps an error return value; nil is returned for a nil
+// error interface.
+func returnedError(v reflect.Value) error {
+	if v.IsNil() {
+		return nil
+	}
+	return v.Interface().(error)
+}
+
+// bindReceiver fixes up the receiver slot of args for static methods: methods
+// that ignore their receiver are given a zero value and plain functions have
+// the slot dropped.
+func (md *GoMethodMetadata) bindReceiver(args []reflect.Value) []reflect.Value {
+	if !md.IsStatic {
+		if md.receiverType != nil {
+			args[0] = md.receiverValue(args[0].Interface().(GDClass))
+		}
+		return args
+	}
+	if md.receiverType == nil {
+		return args[1:]
+	}
+	args[0] = reflect.Zero(md.receiverType)
+	return args
+}
+
+// receiverValue returns inst as the receiver of the method. Methods bound
+// by a Go parent class are called on the embedded parent struct.
+func (md *GoMethodMetadata) receiverValue(inst GDClass) reflect.Value {
+	v := reflect.ValueOf(inst)
+	if md.receiverType == nil {
+		return v
+	}
+	for v.Type() != md.receiverType {
+		if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct ||
+			v.Elem().NumField() == 0 || !v.Type().Elem().Field(0).Anonymous {
+			log.Panic("receiver does not embed the class of the method",
+				zap.String("bind", md.String()),
+				zap.Any("receiver", reflect.TypeOf(inst)),
+			)
+		}
+		v = v.Elem().Field(0).Addr()
+	}
+	return v
+}
+
 func (md *GoMethodMetadata) String() string {
 	if md == nil {
 		log.Panic("GoMethodMetadata cannot be null")
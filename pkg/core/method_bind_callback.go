@@ -26,22 +26,14 @@ func GoCallback_MethodBindMethodCall(
 	rReturn C.GDExtensionVariantPtr,
 	rError *C.GDExtensionCallError,
 ) {
-	// TODO: implement rError checking
 	ud := (cgo.Handle)(methodUserData)
 	bind, ok := ud.Value().(*GoMethodMetadata)
 	if !ok || bind == nil {
 		log.Panic("unable to retrieve methodUserData")
 	}
-	pnr.Pin(instPtr)
-	inst := ObjectClassFromGDExtensionClassInstancePtr((GDExtensionClassInstancePtr)(instPtr))
-	if inst == nil {
-		log.Panic("GDExtensionClassInstancePtr canoot be null")
-	}
-	pnr.Pin(inst)
-	cn := inst.GetClass()
-	defer cn.Destroy()
+	inst := methodBindInstance(bind, (GDExtensionClassInstancePtr)(instPtr))
 	log.Debug("GoCallback_MethodBindMethodCall called",
-		zap.String("class", cn.ToUtf8()),
+		zap.String("class", bind.ClassName),
 		zap.String("method", bind.GdMethodName),
 		zap.String("bind", bind.String()),
 	)
@@ -51,7 +43,14 @@ func GoCallback_MethodBindMethodCall(
 		pnr.Pin(argPtrSlice[i])
 		args[i] = NewVariantCopyWithGDExtensionConstVariantPtr(argPtrSlice[i])
 	}
-	retCall := bind.Call(inst, args...)
+	retCall, err := bind.Call(inst, args...)
+	// methods returning only an error hand the Error code back to GDScript
+	// instead of failing the call; the engine has no call error for a
+	// failed method, so the script error printed by reportError carries the
+	// reason
+	if err != nil && bind.GoReturnStyle == ValueAndErrorReturnStyle {
+		(*GDExtensionCallError)(unsafe.Pointer(rError)).SetErrorFields(GDEXTENSION_CALL_ERROR_INVALID_METHOD, 0, 0)
+	}
 	*(*Variant)(unsafe.Pointer(rReturn)) = retCall
 	pnr.Pin(rReturn)
 }
@@ -70,14 +69,9 @@ func GoCallback_MethodBindMethodPtrcall(
 	if !ok || bind == nil {
 		log.Panic("unable to retrieve methodUserData")
 	}
-	inst := ObjectClassFromGDExtensionClassInstancePtr((GDExtensionClassInstancePtr)(instPtr))
-	if inst == nil {
-		log.Panic("GDExtensionClassInstancePtr canoot be null")
-	}
-	cn := inst.GetClass()
-	defer cn.Destroy()
+	inst := methodBindInstance(bind, (GDExtensionClassInstancePtr)(instPtr))
 	log.Debug("GoCallback_MethodBindMethodPtrcall called",
-		zap.String("class", cn.ToUtf8()),
+		zap.String("class", bind.ClassName),
 		zap.String("method", bind.String()),
 	)
 	sliceLen := len(bind.GoArgumentTypes)
@@ -89,3 +83,20 @@ func GoCallback_MethodBindMethodPtrcall(
 	)
 	pnr.Pin(rReturn)
 }
+
+// methodBindInstance resolves the receiver of a method call. Static methods
+// are called by Godot without an instance, so nil is returned for those.
+func methodBindInstance(bind *GoMethodMetadata, instPtr GDExtensionClassInstancePtr) GDClass {
+	if bind.IsStatic {
+		return nil
+	}
+	pnr.Pin(instPtr)
+	inst := ObjectClassFromGDExtensionClassInstancePtr(instPtr)
+	if inst == nil {
+		log.Panic("GDExtensionClassInstancePtr canoot be null",
+			zap.String("bind", bind.String()),
+		)
+	}
+	pnr.Pin(inst)
+	return inst
+}
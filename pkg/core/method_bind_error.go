@@ -0,0 +1,67 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/constant"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// errorCode returns the Godot Error wrapped by err. OK is returned for a nil
+// error and FAILED for errors that do not wrap an Error value.
+func errorCode(err error) Error {
+	if err == nil {
+		return OK
+	}
+	var code Error
+	if errors.As(err, &code) {
+		return code
+	}
+	return FAILED
+}
+
+// reportError prints err as a script error in Godot with the Go class and
+// method names that returned it.
+func (md *GoMethodMetadata) reportError(err error) {
+	log.Debug("bound method returned error",
+		zap.String("bind", md.String()),
+		zap.Error(err),
+	)
+	var (
+		file string
+		line int
+	)
+	if f := runtime.FuncForPC(md.Func.Pointer()); f != nil {
+		file, line = f.FileLine(f.Entry())
+	}
+	CallFunc_GDExtensionInterfacePrintScriptErrorWithMessage(
+		fmt.Sprintf("%s.%s returned an error", md.ClassName, md.GoMethodName),
+		err.Error(),
+		md.GdMethodName,
+		file,
+		int32(line),
+		1,
+	)
+}
+
+// encodeZeroTypePtr encodes the value a failed (T, error) ptrcall returns:
+// nil for objects and refs, an empty value for engine types such as Array,
+// whose zero value is not valid, and the zero value of T otherwise.
+func encodeZeroTypePtr(t reflect.Type, rOut GDExtensionUninitializedTypePtr) {
+	switch {
+	case t.Implements(refType):
+		encodeRefTypePtr(nil, rOut)
+	case t.Kind() == reflect.Pointer || t.Kind() == reflect.Interface:
+		*(*GDExtensionObjectPtr)(unsafe.Pointer(rOut)) = nil
+	case isDestroyable(t):
+		GDExtensionTypePtrFromReflectValue(copyFieldValue(reflect.Zero(t)), rOut)
+	default:
+		GDExtensionTypePtrFromReflectValue(reflect.Zero(t), rOut)
+	}
+}
@@ -157,10 +157,8 @@ func convertVariantToGoTypeReflectValue(arg Variant, t reflect.Type) (reflect.Va
 			zap.String("type", "string"),
 		)
 		return reflect.ValueOf(typedValue), nil
-	case reflect.Slice:
-		log.Panic("slice not implemented",
-			zap.Any("stringify", arg.Stringify()),
-		)
+	case reflect.Slice, reflect.Map:
+		return reflectContainerFromVariant(arg, t)
 	case reflect.Interface:
 		switch {
 		case t.Implements(refType):
@@ -247,6 +245,9 @@ func convertVariantToGoTypeReflectValue(arg Variant, t reflect.Type) (reflect.Va
 		case Vector4i:
 			v := arg.ToVector4i()
 			return reflect.ValueOf(v), nil
+		case Color:
+			v := arg.ToColor()
+			return reflect.ValueOf(v), nil
 		case PackedByteArray:
 			v := arg.ToPackedByteArray()
 			return reflect.ValueOf(v), nil
@@ -284,28 +285,35 @@ func convertVariantToGoTypeReflectValue(arg Variant, t reflect.Type) (reflect.Va
 			v := arg.ToCallable()
 			return reflect.ValueOf(v), nil
 		default:
-			log.Panic("unsupported array type",
-				zap.Any("type", t),
-			)
+			// other builtin classes such as String and Array are copied
+			enc, vt, ok := EncoderForType(t)
+			if !ok {
+				log.Panic("unsupported array type",
+					zap.Any("type", t),
+				)
+			}
+			if arg.GetType() != vt {
+				converted, err := NewVariantConstructed(vt, arg)
+				if err != nil {
+					return out, err
+				}
+				defer converted.Destroy()
+				arg = converted
+			}
+			return enc.DecodeReflectVariantPtr(arg.NativeConstPtr()), nil
 		}
 	case reflect.Pointer:
 		switch {
 		case t.Implements(refType):
-			// TODO: is this coming out as a Ref type here?
-			obj := arg.ToObject()
-			ref, ok := obj.(Ref)
-			if !ok {
-				log.Panic("not a ref instance",
-					zap.String("value", arg.Stringify()),
-				)
-			}
 			log.Debug("ptrcall arg parsed",
 				zap.String("type", "Ref"),
 			)
-			return reflect.ValueOf(ref), nil
+			return newRefReflectValue(t, variantObjectPtr(arg)), nil
 		case t.Implements(gdClassType):
-			obj := arg.ToObject()
-			// NOTE: add .Elem() if we want to support
+			obj := objectFromGDExtensionObjectPtr(variantObjectPtr(arg))
+			if obj == nil {
+				return reflect.Zero(t), nil
+			}
 			return reflect.ValueOf(obj), nil
 		default:
 			log.Panic("unsupported pointer type",
@@ -328,9 +336,11 @@ func convertVariantToGoTypeReflectValue(arg Variant, t reflect.Type) (reflect.Va
 			)
 			return reflect.ValueOf(ref), nil
 		default:
-			log.Panic("unsupported struct type",
-				zap.Any("type", t),
-			)
+			se, _, err := structValueEncoderFor(t)
+			if err != nil {
+				return out, err
+			}
+			return se.DecodeVariant(&arg)
 		}
 	default:
 		log.Panic("unsupported type",
@@ -465,19 +475,37 @@ func reflectFuncCallArgsFromGDExtensionConstTypePtrSliceArgs(inst GDClass, suppl
 				zap.String("type", "string"),
 			)
 			args[i+1] = reflect.ValueOf(str)
-		case reflect.Slice:
-			slice := *(*[]unsafe.Pointer)(arg)
-			log.Panic("slice not implemented",
-				zap.Any("len", len(slice)),
-				zap.Int("arg_index", i),
-			)
+		case reflect.UnsafePointer:
+			// native pointers such as GDExtensionPtr<void> arguments
+			args[i+1] = reflect.ValueOf(*(*unsafe.Pointer)(arg))
+		case reflect.Slice, reflect.Map:
+			// containers are copied into a Variant to share the varcall conversion
+			v := variantFromContainerTypePtr(ReflectTypeToGDExtensionVariantType(t), arg)
+			value, err := reflectContainerFromVariant(v, t)
+			v.Destroy()
+			if err != nil {
+				log.Panic("error converting container argument",
+					zap.Int("arg_index", i),
+					zap.Any("type", t),
+					zap.Error(err),
+				)
+			}
+			args[i+1] = value
 		case reflect.Interface:
-			v := reflect.Zero(t)
-			inst := v.Interface()
-			switch inst.(type) {
-			case Object:
-				gdObjPtr := (GDExtensionConstObjectPtr)(arg)
-
+			switch {
+			case t.Implements(gdObjectType):
+				// objects are passed as a pointer to the object pointer
+				gdObjPtr := *(*GDExtensionConstObjectPtr)(arg)
+				if gdObjPtr == nil {
+					args[i+1] = reflect.Zero(t)
+					break
+				}
+				id := CallFunc_GDExtensionInterfaceObjectGetInstanceId(gdObjPtr)
+				if inst, ok := Internal.GDClassInstances.Get(id); ok {
+					// instances of Go classes are passed as is
+					args[i+1] = reflect.ValueOf(inst)
+					break
+				}
 				// GDExtensionUninitializedStringNamePtr
 				gdsn := NewStringName()
 				defer gdsn.Destroy()
@@ -584,6 +612,9 @@ func reflectFuncCallArgsFromGDExtensionConstTypePtrSliceArgs(inst GDClass, suppl
 			case Variant:
 				v := NewVariantCopyWithGDExtensionConstVariantPtr((GDExtensionConstVariantPtr)(arg))
 				args[i+1] = reflect.ValueOf(v)
+			case StringName:
+				v := NewStringNameWithStringName(*(*StringName)(unsafe.Pointer(arg)))
+				args[i+1] = reflect.ValueOf(v)
 			case PackedInt64Array:
 				pV := (*PackedInt64Array)(unsafe.Pointer(arg))
 				if pV == nil {
@@ -596,7 +627,23 @@ func reflectFuncCallArgsFromGDExtensionConstTypePtrSliceArgs(inst GDClass, suppl
 				log.Debug("reflect PackedInt64Array", zap.Any("v", Stringify(NewVariantPackedInt64Array(v))))
 				args[i+1] = reflect.ValueOf(v)
 			default:
-				log.Panic(fmt.Sprintf("MethodBind.Ptrcall reflected as array does not support type: %s", t.Name()))
+				_, vt, ok := EncoderForType(t)
+				if !ok {
+					log.Panic(fmt.Sprintf("MethodBind.Ptrcall reflected as array does not support type: %s", t.Name()))
+				}
+				// other builtin classes such as String and Array are copied
+				// into a Variant like containers
+				v := variantFromContainerTypePtr(vt, arg)
+				value, err := convertVariantToGoTypeReflectValue(v, t)
+				v.Destroy()
+				if err != nil {
+					log.Panic("error converting builtin argument",
+						zap.Int("arg_index", i),
+						zap.Any("type", t),
+						zap.Error(err),
+					)
+				}
+				args[i+1] = value
 			}
 		case reflect.Struct:
 			v := reflect.Zero(t)
@@ -672,17 +719,36 @@ func reflectFuncCallArgsFromGDExtensionConstTypePtrSliceArgs(inst GDClass, suppl
 					args[i+1] = reflect.ValueOf(ref)
 					break
 				}
-				log.Panic("unsupported struct type",
-					zap.Int("arg_index", i),
-					zap.Any("type", t),
-				)
+				se, vt, err := structValueEncoderFor(t)
+				if err != nil {
+					log.Panic("unsupported struct type",
+						zap.Int("arg_index", i),
+						zap.Any("type", t),
+						zap.Error(err),
+					)
+				}
+				// structs are copied into a Variant like containers
+				v := variantFromContainerTypePtr(vt, arg)
+				value, err := se.DecodeVariant(&v)
+				v.Destroy()
+				if err != nil {
+					log.Panic("error converting struct argument",
+						zap.Int("arg_index", i),
+						zap.Any("type", t),
+						zap.Error(err),
+					)
+				}
+				args[i+1] = value
 			}
 		case reflect.Pointer:
 			switch {
 			case t.Implements(refType):
-				log.Panic("TODO: missing ref pointer type",
+				// refs are passed as a pointer to the engine Ref<T>
+				gdObjPtr := CallFunc_GDExtensionInterfaceRefGetObject((GDExtensionConstRefPtr)(arg))
+				args[i+1] = newRefReflectValue(t, (GDExtensionConstObjectPtr)(gdObjPtr))
+				log.Debug("ptrcall arg parsed",
 					zap.Int("arg_index", i),
-					zap.Any("type", t),
+					zap.String("type", "Ref"),
 				)
 			case t.Implements(gdClassType):
 				className := t.Elem().Name()
@@ -738,10 +804,35 @@ func validateReturnValues(reflectedRet []reflect.Value, returnStyle ReturnStyle,
 					zap.String("type", reflectedRet[0].Type().Name()),
 				)
 			}
+		case ErrorReturnStyle:
+			if reflectedRet[0].Type() != errorType {
+				log.Panic("expected error return value",
+					zap.String("type", reflectedRet[0].Type().Name()),
+				)
+			}
 		default:
 			log.Panic("unexpected value returned")
 		}
 		return nil
+	case 2:
+		switch returnStyle {
+		case ValueAndBoolReturnStyle:
+			if reflectedRet[1].Kind() != reflect.Bool {
+				log.Panic("expected bool as second return value")
+			}
+		case ValueAndErrorReturnStyle:
+			if reflectedRet[1].Type() != errorType {
+				log.Panic("expected error as second return value")
+			}
+		default:
+			log.Panic("unexpected second value returned")
+		}
+		if expectedReturnType.Name() != reflectedRet[0].Type().Name() {
+			log.Panic("unexpected return type",
+				zap.String("type", reflectedRet[0].Type().Name()),
+			)
+		}
+		return nil
 	default:
 		log.Panic("too many values returned", zap.Any("ret", reflectedRet))
 	}
@@ -0,0 +1,53 @@
+package core
+
+import (
+	"reflect"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	. "github.com/godot-go/godot-go/pkg/util"
+)
+
+// MethodTrampoline calls a bound method with typed arguments instead of going
+// through reflection. godot-go bindgen generates trampolines for the methods
+// of the Go classes in a package and registers them from an init function;
+// methods without a trampoline keep using reflection.
+type MethodTrampoline struct {
+	// Ptrcall decodes args, calls the method and encodes its return value,
+	// if any, to rReturn.
+	Ptrcall func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr)
+	// Call does the same for a varcall; default arguments have already been
+	// filled in and rReturn is set to nil for methods without return value.
+	Call func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr)
+}
+
+// methodTrampolineKey identifies a method by its Go receiver type, so classes
+// of the same name in different packages do not share trampolines.
+type methodTrampolineKey struct {
+	receiverType reflect.Type
+	goMethodName string
+}
+
+var methodTrampolines = NewSyncMap[methodTrampolineKey, *MethodTrampoline]()
+
+// RegisterMethodTrampoline registers t for the method goMethodName of the Go
+// class T. It has to be called before the class is registered.
+func RegisterMethodTrampoline[T GDClass](goMethodName string, t MethodTrampoline) {
+	methodTrampolines.Set(methodTrampolineKey{reflect.TypeFor[T](), goMethodName}, &t)
+}
+
+// lookupMethodTrampoline finds the trampoline of a method bound with md.
+// Static, variadic and error returning methods are always called through
+// reflection.
+func lookupMethodTrampoline(md *GoMethodMetadata) *MethodTrampoline {
+	if md.receiverType == nil || md.IsStatic || md.IsVariadic {
+		return nil
+	}
+	switch md.GoReturnStyle {
+	case NoneReturnStyle, ValueReturnStyle:
+	default:
+		return nil
+	}
+	t, _ := methodTrampolines.Get(methodTrampolineKey{md.receiverType, md.GoMethodName})
+	return t
+}
@@ -0,0 +1,84 @@
+package core
+
+import (
+	"reflect"
+	"slices"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// NotificationHandler is a V_Notification method declared by one level of the
+// embedded struct chain of a class.
+type NotificationHandler struct {
+	FieldIndex []int
+	Method     reflect.Method
+}
+
+var (
+	int32Type = reflect.TypeFor[int32]()
+	boolType  = reflect.TypeFor[bool]()
+)
+
+// newNotificationHandlers finds the V_Notification methods declared along the
+// chain of embedded structs of classType; the result is ordered from the
+// innermost embedded struct to classType itself.
+func newNotificationHandlers(classType reflect.Type) []NotificationHandler {
+	var (
+		handlers []NotificationHandler
+		index    []int
+	)
+	for t := classType; t != nil && t.Kind() == reflect.Struct; {
+		m, ok := reflect.PointerTo(t).MethodByName("V_Notification")
+		if !ok {
+			// nothing further down the chain can declare it either
+			break
+		}
+		var embedded reflect.Type
+		if t.NumField() > 0 && t.Field(0).Anonymous {
+			embedded = t.Field(0).Type
+		}
+		promoted := false
+		if embedded != nil {
+			_, promoted = reflect.PointerTo(embedded).MethodByName("V_Notification")
+		}
+		if !promoted {
+			mt := m.Type
+			if mt.NumIn() != 3 || mt.In(1) != int32Type || mt.In(2) != boolType || mt.NumOut() != 0 {
+				log.Panic("V_Notification must have the signature func(what int32, reversed bool)",
+					zap.String("type", t.Name()),
+					zap.Any("signature", mt),
+				)
+			}
+			handlers = append(handlers, NotificationHandler{
+				FieldIndex: slices.Clone(index),
+				Method:     m,
+			})
+		}
+		t = embedded
+		index = append(index, 0)
+	}
+	slices.Reverse(handlers)
+	return handlers
+}
+
+// Notify dispatches a notification to the V_Notification methods of inst.
+// Like Godot, embedded structs are notified before the structs embedding
+// them unless reversed is true.
+func (c *ClassInfo) Notify(inst GDClass, what int32, reversed bool) {
+	n := len(c.NotificationHandlers)
+	if n == 0 {
+		return
+	}
+	v := reflect.ValueOf(inst).Elem()
+	args := []reflect.Value{{}, reflect.ValueOf(what), reflect.ValueOf(reversed)}
+	for i := 0; i < n; i++ {
+		h := c.NotificationHandlers[i]
+		if reversed {
+			h = c.NotificationHandlers[n-1-i]
+		}
+		args[0] = v.FieldByIndex(h.FieldIndex).Addr()
+		h.Method.Func.Call(args)
+	}
+}
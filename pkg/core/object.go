@@ -14,18 +14,28 @@ func NewSimpleGDExtensionPropertyInfo(
 	className string,
 	variantType GDExtensionVariantType,
 	name string,
+) GDExtensionPropertyInfo {
+	return newHintedGDExtensionPropertyInfo(className, variantType, name, PROPERTY_HINT_NONE, "")
+}
+
+func newHintedGDExtensionPropertyInfo(
+	className string,
+	variantType GDExtensionVariantType,
+	name string,
+	hint PropertyHint,
+	hintString string,
 ) GDExtensionPropertyInfo {
 	classNameStringName := NewStringNameWithLatin1Chars(className)
 	classNamePtr := classNameStringName.AsGDExtensionConstStringNamePtr()
 	nameStringName := NewStringNameWithLatin1Chars(name)
 	namePtr := nameStringName.AsGDExtensionConstStringNamePtr()
-	hintString := NewStringWithUtf8Chars("")
-	hintPtr := hintString.AsGDExtensionConstStringPtr()
+	hintGDString := NewStringWithUtf8Chars(hintString)
+	hintPtr := hintGDString.AsGDExtensionConstStringPtr()
 	ret := NewGDExtensionPropertyInfo(
 		classNamePtr,
 		variantType,
 		namePtr,
-		uint32(PROPERTY_HINT_NONE),
+		uint32(hint),
 		hintPtr,
 		uint32(PROPERTY_USAGE_DEFAULT),
 	)
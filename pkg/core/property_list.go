@@ -0,0 +1,127 @@
+package core
+
+import (
+	"reflect"
+	"runtime"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/constant"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	. "github.com/godot-go/godot-go/pkg/util"
+)
+
+// PropertyInfo describes a property returned by V_GetPropertyList. A zero
+// Usage is PROPERTY_USAGE_DEFAULT.
+type PropertyInfo struct {
+	Type       GDExtensionVariantType
+	Name       string
+	ClassName  string
+	Hint       PropertyHint
+	HintString string
+	Usage      PropertyUsageFlags
+}
+
+// PropertyLister is implemented by Go classes whose properties depend on the
+// state of the instance, like _get_property_list in GDScript. The properties
+// are listed after the property list the class was registered with; V_Get and
+// V_Set read and write their values.
+type PropertyLister interface {
+	V_GetPropertyList() []PropertyInfo
+}
+
+var propertyListerType = reflect.TypeFor[PropertyLister]()
+
+// propertyInfoList owns the memory of a property list built for a single
+// get_property_list call; it is released when Godot frees the list.
+type propertyInfoList struct {
+	pnr         runtime.Pinner
+	infos       []GDExtensionPropertyInfo
+	stringNames []*StringName
+	strings     []*String
+}
+
+// propertyInfoLists holds the lists Godot has not freed yet, keyed by the
+// pointer returned to Godot.
+var propertyInfoLists = NewSyncMap[*GDExtensionPropertyInfo, *propertyInfoList]()
+
+// newPropertyInfoList lists the registered properties of the class followed
+// by props. The registered properties keep pointing to the names owned by the
+// class, which outlive the list.
+func newPropertyInfoList(registered []GDExtensionPropertyInfo, props []PropertyInfo) *propertyInfoList {
+	l := &propertyInfoList{
+		infos: make([]GDExtensionPropertyInfo, 0, len(registered)+len(props)),
+	}
+	l.infos = append(l.infos, registered...)
+	for _, p := range props {
+		usage := p.Usage
+		if usage == 0 {
+			usage = PROPERTY_USAGE_DEFAULT
+		}
+		l.infos = append(l.infos, NewGDExtensionPropertyInfo(
+			l.stringName(p.ClassName).AsGDExtensionConstStringNamePtr(),
+			p.Type,
+			l.stringName(p.Name).AsGDExtensionConstStringNamePtr(),
+			uint32(p.Hint),
+			l.string(p.HintString).AsGDExtensionConstStringPtr(),
+			uint32(usage),
+		))
+	}
+	return l
+}
+
+func (l *propertyInfoList) stringName(v string) *StringName {
+	sn := new(StringName)
+	*sn = NewStringNameWithUtf8Chars(v)
+	l.pnr.Pin(sn)
+	l.stringNames = append(l.stringNames, sn)
+	return sn
+}
+
+func (l *propertyInfoList) string(v string) *String {
+	s := new(String)
+	*s = NewStringWithUtf8Chars(v)
+	l.pnr.Pin(s)
+	l.strings = append(l.strings, s)
+	return s
+}
+
+// keep pins the list and keeps it alive until freePropertyInfoList is called
+// with the returned pointer.
+func (l *propertyInfoList) keep() *GDExtensionPropertyInfo {
+	if len(l.infos) == 0 {
+		l.release()
+		return nil
+	}
+	ptr := unsafe.SliceData(l.infos)
+	l.pnr.Pin(ptr)
+	propertyInfoLists.Set(ptr, l)
+	return ptr
+}
+
+// freePropertyInfoList releases the list returned for ptr; the registered
+// property list of a class is not tracked and stays untouched.
+func freePropertyInfoList(ptr *GDExtensionPropertyInfo) {
+	if ptr == nil {
+		return
+	}
+	l, ok := propertyInfoLists.Get(ptr)
+	if !ok {
+		return
+	}
+	propertyInfoLists.Delete(ptr)
+	l.release()
+}
+
+func (l *propertyInfoList) release() {
+	for _, sn := range l.stringNames {
+		sn.Destroy()
+	}
+	for _, s := range l.strings {
+		s.Destroy()
+	}
+	l.stringNames = nil
+	l.strings = nil
+	l.infos = nil
+	l.pnr.Unpin()
+}
@@ -0,0 +1,120 @@
+package core
+
+import (
+	"strconv"
+	"strings"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/constant"
+)
+
+// PropertyOptions describes how a property is presented by the inspector.
+type PropertyOptions struct {
+	Hint       PropertyHint
+	HintString string
+	Usage      PropertyUsageFlags
+	ClassName  string
+	Default    *Variant
+}
+
+// PropertyOption configures the PropertyOptions of ClassDBAddProperty.
+type PropertyOption func(*PropertyOptions)
+
+func newPropertyOptions(opts []PropertyOption) PropertyOptions {
+	po := PropertyOptions{
+		Hint:  PROPERTY_HINT_NONE,
+		Usage: PROPERTY_USAGE_DEFAULT,
+	}
+	for _, opt := range opts {
+		opt(&po)
+	}
+	return po
+}
+
+// propertyClassName returns the class name reported with the property; like
+// godot-cpp, resource type hints use the hint string as the class name.
+func (po PropertyOptions) propertyClassName(ownerClassName string) string {
+	switch {
+	case po.Hint == PROPERTY_HINT_RESOURCE_TYPE:
+		return po.HintString
+	case po.ClassName != "":
+		return po.ClassName
+	default:
+		return ownerClassName
+	}
+}
+
+// WithPropertyHint sets a hint and hint string.
+func WithPropertyHint(hint PropertyHint, hintString string) PropertyOption {
+	return func(po *PropertyOptions) {
+		po.Hint = hint
+		po.HintString = hintString
+	}
+}
+
+// WithPropertyUsage replaces the default PROPERTY_USAGE_DEFAULT usage flags.
+func WithPropertyUsage(usage PropertyUsageFlags) PropertyOption {
+	return func(po *PropertyOptions) {
+		po.Usage = usage
+	}
+}
+
+// WithPropertyClassName sets the class name of an object property.
+func WithPropertyClassName(className string) PropertyOption {
+	return func(po *PropertyOptions) {
+		po.ClassName = className
+	}
+}
+
+// WithPropertyDefault registers the value the inspector reverts the property
+// to; the property can be reverted whenever its value differs from it. The
+// value is copied when the property is added, so the caller still destroys v.
+func WithPropertyDefault(v Variant) PropertyOption {
+	return func(po *PropertyOptions) {
+		po.Default = &v
+	}
+}
+
+// PropertyRange limits a numeric property to [min, max] with step
+// increments. extras are appended to the hint string, e.g. "or_greater".
+func PropertyRange(min, max, step float64, extras ...string) PropertyOption {
+	parts := []string{
+		strconv.FormatFloat(min, 'g', -1, 64),
+		strconv.FormatFloat(max, 'g', -1, 64),
+		strconv.FormatFloat(step, 'g', -1, 64),
+	}
+	parts = append(parts, extras...)
+	return WithPropertyHint(PROPERTY_HINT_RANGE, strings.Join(parts, ","))
+}
+
+// PropertyEnum presents an int or string property as a list of names.
+// Names may carry explicit values, e.g. "Hello:1".
+func PropertyEnum(names ...string) PropertyOption {
+	return WithPropertyHint(PROPERTY_HINT_ENUM, strings.Join(names, ","))
+}
+
+// PropertyFlags presents an int property as a set of bit flags.
+func PropertyFlags(names ...string) PropertyOption {
+	return WithPropertyHint(PROPERTY_HINT_FLAGS, strings.Join(names, ","))
+}
+
+// PropertyFile presents a string property as a file picker limited to
+// filters, e.g. "*.png".
+func PropertyFile(filters ...string) PropertyOption {
+	return WithPropertyHint(PROPERTY_HINT_FILE, strings.Join(filters, ","))
+}
+
+// PropertyDir presents a string property as a directory picker.
+func PropertyDir() PropertyOption {
+	return WithPropertyHint(PROPERTY_HINT_DIR, "")
+}
+
+// PropertyMultiline presents a string property as a multiline text editor.
+func PropertyMultiline() PropertyOption {
+	return WithPropertyHint(PROPERTY_HINT_MULTILINE_TEXT, "")
+}
+
+// PropertyResourceType limits an object property to resources of className.
+func PropertyResourceType(className string) PropertyOption {
+	return WithPropertyHint(PROPERTY_HINT_RESOURCE_TYPE, className)
+}
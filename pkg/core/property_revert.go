@@ -0,0 +1,101 @@
+package core
+
+import (
+	"reflect"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// PropertyDefault is the value a property reverts to in the inspector.
+type PropertyDefault struct {
+	Value  Variant
+	Getter *GoMethodMetadata
+}
+
+var gdStringNameType = reflect.TypeFor[StringName]()
+
+// validatePropertyRevertVirtual checks the signature of a method bound as
+// _property_can_revert or _property_get_revert, which are called with the
+// property name and whose results are read without further checks.
+func validatePropertyRevertVirtual(md *GoMethodMetadata) {
+	var signature string
+	switch md.GdMethodName {
+	case "_property_can_revert":
+		signature = "func(name string) bool"
+	case "_property_get_revert":
+		signature = "func(name string) (Variant, bool)"
+	default:
+		return
+	}
+	mt := md.Func.Type()
+	ok := mt.NumIn() == 2 && (mt.In(1).Kind() == reflect.String || mt.In(1) == gdStringNameType)
+	if md.GdMethodName == "_property_can_revert" {
+		ok = ok && mt.NumOut() == 1 && mt.Out(0).Kind() == reflect.Bool
+	} else {
+		ok = ok && mt.NumOut() == 2 && mt.Out(0) == gdVariantType && mt.Out(1).Kind() == reflect.Bool
+	}
+	if !ok {
+		log.Panic(md.GoMethodName+" must have the signature "+signature+"; name may also be a StringName",
+			zap.String("class", md.ClassName),
+			zap.Any("signature", mt),
+		)
+	}
+}
+
+// PropertyCanRevert reports whether the inspector should offer to revert the
+// property: either the bound _property_can_revert virtual says so or the
+// current value differs from the registered default.
+func (c *ClassInfo) PropertyCanRevert(inst GDClass, name *StringName) bool {
+	if mb, ok := c.LookupVirtualMethod("_property_can_revert"); ok {
+		ret := mb.GoMethodMetadata.Func.Call(propertyNameCallArgs(mb.GoMethodMetadata, inst, name))
+		if ret[0].Bool() {
+			return true
+		}
+	}
+	d, ok := c.PropertyDefaults[name.ToUtf8()]
+	if !ok {
+		return false
+	}
+	current, err := d.Getter.Call(inst)
+	if err != nil {
+		return false
+	}
+	defer current.Destroy()
+	return !current.Equal(d.Value)
+}
+
+// PropertyGetRevert writes the value the property reverts to into rRet. The
+// bound _property_get_revert virtual takes precedence over the registered
+// default.
+func (c *ClassInfo) PropertyGetRevert(inst GDClass, name *StringName, rRet GDExtensionVariantPtr) bool {
+	if mb, ok := c.LookupVirtualMethod("_property_get_revert"); ok {
+		ret := mb.GoMethodMetadata.Func.Call(propertyNameCallArgs(mb.GoMethodMetadata, inst, name))
+		if ret[1].Bool() {
+			*(*Variant)(unsafe.Pointer(rRet)) = ret[0].Interface().(Variant)
+			return true
+		}
+	}
+	d, ok := c.PropertyDefaults[name.ToUtf8()]
+	if !ok {
+		return false
+	}
+	CallFunc_GDExtensionInterfaceVariantNewCopy((GDExtensionUninitializedVariantPtr)(rRet), d.Value.NativeConstPtr())
+	return true
+}
+
+// propertyNameCallArgs builds the arguments of a virtual taking the property
+// name either as a string or a StringName; the signature is checked by
+// validatePropertyRevertVirtual.
+func propertyNameCallArgs(md *GoMethodMetadata, inst GDClass, name *StringName) []reflect.Value {
+	var arg reflect.Value
+	if md.GoArgumentTypes[0].Kind() == reflect.String {
+		arg = reflect.ValueOf(name.ToUtf8())
+	} else {
+		arg = reflect.ValueOf(*name)
+	}
+	return []reflect.Value{md.receiverValue(inst), arg}
+}
@@ -0,0 +1,131 @@
+package core
+
+import (
+	"reflect"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	. "github.com/godot-go/godot-go/pkg/gdclassinit"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// ReferenceNotifier is implemented by RefCounted classes that want to know
+// when the engine takes or drops a reference to their object. The methods
+// are called after the reference count changed and are not bound as
+// virtuals.
+type ReferenceNotifier interface {
+	V_Reference()
+	V_Unreference()
+}
+
+var referenceNotifierType = reflect.TypeFor[ReferenceNotifier]()
+
+// RefCountedGDClass is a Go class embedding RefCountedImpl.
+type RefCountedGDClass interface {
+	GDClass
+	RefCounted
+	comparable
+}
+
+// NewGDClassRef creates an instance of the registered RefCounted class T and
+// returns the first reference to it. The instance is released once the
+// reference and every reference taken by the engine are gone.
+func NewGDClassRef[T RefCountedGDClass]() *TypedRef[T] {
+	var zero T
+	inst := CreateGDClassInstance(zero.GetClassName()).(T)
+	return NewTypedRef(inst)
+}
+
+// objectFromGDExtensionObjectPtr returns the Go instance bound to the
+// engine object for Go classes and a wrapper of the native class otherwise.
+func objectFromGDExtensionObjectPtr(gdObjPtr GDExtensionConstObjectPtr) Object {
+	if gdObjPtr == nil {
+		return nil
+	}
+	id := CallFunc_GDExtensionInterfaceObjectGetInstanceId(gdObjPtr)
+	if inst, ok := Internal.GDClassInstances.Get(id); ok {
+		return inst.(Object)
+	}
+	gdsn := NewStringName()
+	defer gdsn.Destroy()
+	ptr := (GDExtensionUninitializedStringNamePtr)(unsafe.Pointer(gdsn.NativePtr()))
+	if CallFunc_GDExtensionInterfaceObjectGetClassName(gdObjPtr, FFI.Library, ptr) == 0 {
+		log.Panic("failed to get class name",
+			zap.Any("gdObjPtr", gdObjPtr),
+		)
+	}
+	className := gdsn.ToUtf8()
+	constructor, ok := GDNativeConstructors.Get(className)
+	if !ok {
+		log.Panic("does not support gdextension class type",
+			zap.String("class_name", className),
+		)
+	}
+	return constructor((*GodotObject)(gdObjPtr)).(Object)
+}
+
+// variantObjectPtr returns the object held by arg; nil is returned when arg
+// holds no object or a freed one.
+func variantObjectPtr(arg Variant) GDExtensionConstObjectPtr {
+	if arg.GetType() != GDEXTENSION_VARIANT_TYPE_OBJECT {
+		return nil
+	}
+	id := CallFunc_GDExtensionInterfaceVariantGetObjectInstanceId(arg.NativeConstPtr())
+	if id == 0 {
+		return nil
+	}
+	return (GDExtensionConstObjectPtr)(CallFunc_GDExtensionInterfaceObjectGetInstanceFromId(id))
+}
+
+// newRefReflectValue creates a value of the Ref pointer type t, such as
+// *TypedRef[*ExampleRef], referring to the engine object gdObjPtr. The ref
+// is borrowed: the caller keeps its reference for the duration of the call,
+// so the reference count is left unchanged.
+func newRefReflectValue(t reflect.Type, gdObjPtr GDExtensionConstObjectPtr) reflect.Value {
+	ref := reflect.New(t.Elem())
+	obj := objectFromGDExtensionObjectPtr(gdObjPtr)
+	if obj == nil {
+		return ref
+	}
+	if _, ok := obj.(RefCounted); !ok {
+		log.Panic("object is not RefCounted",
+			zap.String("class", obj.GetClassName()),
+			zap.Any("type", t),
+		)
+	}
+	ref.Elem().FieldByName("Reference").Set(reflect.ValueOf(obj))
+	return ref
+}
+
+// refObjectPtr returns the object ref refers to; nil is returned for an
+// invalid ref.
+func refObjectPtr(ref Ref) GDExtensionObjectPtr {
+	if ref == nil || !ref.IsValid() {
+		return nil
+	}
+	return ref.Ptr().AsGDExtensionObjectPtr()
+}
+
+// encodeRefTypePtr hands a Ref returned from Go to the engine Ref<T> at
+// rOut. The engine takes its own reference and the Go reference is dropped,
+// leaving ref invalid.
+func encodeRefTypePtr(ref Ref, rOut GDExtensionUninitializedTypePtr) {
+	CallFunc_GDExtensionInterfaceRefSetObject((GDExtensionRefPtr)(rOut), refObjectPtr(ref))
+	if ref != nil && ref.IsValid() {
+		ref.Unref()
+	}
+}
+
+// encodeRefVariantPtr hands a Ref returned from Go to the engine as an
+// object Variant at rOut, which holds its own reference; the Go reference
+// is dropped, leaving ref invalid.
+func encodeRefVariantPtr(ref Ref, rOut GDExtensionUninitializedVariantPtr) {
+	if ref == nil || !ref.IsValid() {
+		CallFunc_GDExtensionInterfaceVariantNewNil(rOut)
+		return
+	}
+	ObjectEncoder.EncodeVariantPtrArg(ref.Ptr(), rOut)
+	ref.Unref()
+}
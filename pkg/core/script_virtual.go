@@ -0,0 +1,155 @@
+package core
+
+import (
+	"reflect"
+	"runtime"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// VirtualMethodParam is an argument of a virtual method declared by
+// ClassDBAddVirtualMethod.
+type VirtualMethodParam struct {
+	Type GDExtensionVariantType
+	Name string
+}
+
+// ClassDBAddVirtualMethod declares the virtual method name on the class of t
+// for scripts extending the class to override, like the virtual methods of
+// the engine classes. A ret of GDEXTENSION_VARIANT_TYPE_NIL declares a method
+// without return value. The Go class calls the override with
+// CallScriptVirtual.
+func ClassDBAddVirtualMethod(t GDClass, name string, args []VirtualMethodParam, ret GDExtensionVariantType) {
+	log.Debug("ClassDBAddVirtualMethod called",
+		zap.String("name", name),
+		zap.Any("args", args),
+		zap.Any("ret", ret),
+	)
+	typeName := t.GetClassName()
+	ci, ok := Internal.GDRegisteredGDClasses.Get(typeName)
+	if !ok {
+		log.Panic("Class doesn't exist.", zap.String("class", typeName))
+		return
+	}
+	if _, ok = ci.ScriptVirtualNameSet[name]; ok {
+		log.Panic("Virtual method already registered.",
+			zap.String("class", typeName),
+			zap.String("name", name),
+		)
+		return
+	}
+	if _, ok = ci.MethodMap[name]; ok {
+		log.Panic("Virtual method conflicts with a bound method.",
+			zap.String("class", typeName),
+			zap.String("name", name),
+		)
+		return
+	}
+	ci.ScriptVirtualNameSet[name] = struct{}{}
+	argInfos := make([]GDExtensionPropertyInfo, len(args))
+	argMetadata := make([]GDExtensionClassMethodArgumentMetadata, len(args))
+	for i, a := range args {
+		argInfos[i] = NewSimpleGDExtensionPropertyInfo("", a.Type, a.Name)
+		defer argInfos[i].Destroy()
+		argMetadata[i] = GDEXTENSION_METHOD_ARGUMENT_METADATA_NONE
+	}
+	var (
+		pArgInfos    *GDExtensionPropertyInfo
+		pArgMetadata *GDExtensionClassMethodArgumentMetadata
+	)
+	if len(args) > 0 {
+		pArgInfos = &argInfos[0]
+		pArgMetadata = &argMetadata[0]
+	}
+	retInfo := NewSimpleGDExtensionPropertyInfo("", ret, "")
+	defer retInfo.Destroy()
+	snName := NewStringNameWithLatin1Chars(name)
+	defer snName.Destroy()
+	info := NewGDExtensionClassVirtualMethodInfo(
+		snName.AsGDExtensionConstStringNamePtr(),
+		uint32(GDEXTENSION_METHOD_FLAG_NORMAL|GDEXTENSION_METHOD_FLAG_VIRTUAL),
+		retInfo,
+		GDEXTENSION_METHOD_ARGUMENT_METADATA_NONE,
+		uint32(len(args)),
+		pArgInfos,
+		pArgMetadata,
+	)
+	snTypeName := NewStringNameWithLatin1Chars(typeName)
+	defer snTypeName.Destroy()
+	log.Info("register script virtual method",
+		zap.String("class", typeName),
+		zap.String("name", name),
+	)
+	CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClassVirtualMethod(
+		FFI.Library,
+		snTypeName.AsGDExtensionConstStringNamePtr(),
+		info,
+	)
+}
+
+// CallScriptVirtual calls the script override of the virtual method name on
+// inst and converts its return value to T; use Variant to get the return
+// value as is. ok is false when the script attached to inst does not
+// implement the method or the call failed, so the Go class can fall back to
+// its default behaviour.
+func CallScriptVirtual[T any](inst Object, name string, args ...Variant) (ret T, ok bool) {
+	objPtr := inst.AsGDExtensionObjectPtr()
+	snName := NewStringNameWithLatin1Chars(name)
+	defer snName.Destroy()
+	if CallFunc_GDExtensionInterfaceObjectHasScriptMethod(
+		(GDExtensionConstObjectPtr)(objPtr),
+		snName.AsGDExtensionConstStringNamePtr(),
+	) == 0 {
+		return ret, false
+	}
+	// the engine reads the arguments through pointers to Go memory
+	var p runtime.Pinner
+	defer p.Unpin()
+	argPtrs := make([]GDExtensionConstVariantPtr, len(args))
+	for i := range args {
+		p.Pin(&args[i])
+		argPtrs[i] = args[i].NativeConstPtr()
+	}
+	var pArgPtrs *GDExtensionConstVariantPtr
+	if len(argPtrs) > 0 {
+		p.Pin(unsafe.SliceData(argPtrs))
+		pArgPtrs = unsafe.SliceData(argPtrs)
+	}
+	var (
+		result  Variant
+		callErr GDExtensionCallError
+	)
+	CallFunc_GDExtensionInterfaceObjectCallScriptMethod(
+		objPtr,
+		snName.AsGDExtensionConstStringNamePtr(),
+		pArgPtrs,
+		GDExtensionInt(len(args)),
+		(GDExtensionUninitializedVariantPtr)(result.NativePtr()),
+		&callErr,
+	)
+	defer result.Destroy()
+	if !callErr.Ok() {
+		log.Warn("unable to call script virtual method",
+			zap.String("class", inst.GetClassName()),
+			zap.String("name", name),
+			zap.Error(callErr),
+		)
+		return ret, false
+	}
+	t := reflect.TypeFor[T]()
+	if t.Kind() == reflect.Interface && result.IsNil() {
+		return ret, true
+	}
+	v, err := convertVariantToGoTypeReflectValue(result, t)
+	if err != nil {
+		log.Panic("unable to convert script virtual method return value",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+	}
+	return v.Interface().(T), true
+}
@@ -0,0 +1,467 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/constant"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// typedSignal is implemented by the SignalN field types.
+type typedSignal interface {
+	signalArgTypes() []reflect.Type
+	bindSignal(owner Object, name string)
+}
+
+var typedSignalType = reflect.TypeFor[typedSignal]()
+
+// signalField is the state shared by the SignalN types. The owner is set when
+// an instance of the class declaring the field is created.
+type signalField struct {
+	owner Object
+	name  string
+}
+
+func (s *signalField) bindSignal(owner Object, name string) {
+	s.owner = owner
+	s.name = name
+}
+
+func (s *signalField) emit(args ...reflect.Value) Error {
+	if s.owner == nil {
+		log.Panic("signal emitted before it was bound to an instance; register the field with ClassDBAddTypedSignal")
+	}
+	variants := make([]Variant, len(args))
+	for i, a := range args {
+		variants[i] = signalArgToVariant(a)
+		defer variants[i].Destroy()
+	}
+	snName := NewStringNameWithLatin1Chars(s.name)
+	defer snName.Destroy()
+	return s.owner.EmitSignal(snName, variants...)
+}
+
+// connect connects handler to the signal; handler is only called with
+// exactly argCount arguments.
+func (s *signalField) connect(argCount int, handler func(args []Variant) error) (SignalConnection, Error) {
+	if s.owner == nil {
+		log.Panic("signal connected before it was bound to an instance; register the field with ClassDBAddTypedSignal")
+	}
+	name := s.name
+	callable := NewCallableFromFunc(func(args ...Variant) {
+		if len(args) != argCount {
+			log.Warn("typed signal handler called with the wrong number of arguments",
+				zap.String("signal", name),
+				zap.Int("expected", argCount),
+				zap.Int("actual", len(args)),
+			)
+			return
+		}
+		if err := handler(args); err != nil {
+			log.Warn("unable to call typed signal handler",
+				zap.String("signal", name),
+				zap.Error(err),
+			)
+		}
+	})
+	snName := NewStringNameWithLatin1Chars(s.name)
+	defer snName.Destroy()
+	if err := s.owner.Connect(snName, callable, 0); err != OK {
+		callable.Destroy()
+		return SignalConnection{}, err
+	}
+	return SignalConnection{
+		owner:    s.owner,
+		ownerID:  CallFunc_GDExtensionInterfaceObjectGetInstanceId((GDExtensionConstObjectPtr)(s.owner.GetGodotObjectOwner())),
+		name:     s.name,
+		callable: callable,
+	}, OK
+}
+
+// SignalConnection is a handler connected with the Connect method of a typed
+// signal.
+type SignalConnection struct {
+	owner    Object
+	ownerID  GDObjectInstanceID
+	name     string
+	callable Callable
+}
+
+// Disconnect disconnects the handler from the signal. It does nothing when
+// the handler is already disconnected or the instance declaring the signal
+// has been freed.
+func (c *SignalConnection) Disconnect() {
+	if c.owner == nil {
+		return
+	}
+	defer func() {
+		c.callable.Destroy()
+		*c = SignalConnection{}
+	}()
+	if CallFunc_GDExtensionInterfaceObjectGetInstanceFromId(c.ownerID) == nil {
+		return
+	}
+	snName := NewStringNameWithLatin1Chars(c.name)
+	defer snName.Destroy()
+	if c.owner.IsConnected(snName, c.callable) {
+		c.owner.Disconnect(snName, c.callable)
+	}
+}
+
+// Signal0 is a signal without arguments declared as a field of a Go class.
+type Signal0 struct {
+	signalField
+}
+
+// Emit emits the signal from the instance declaring the field.
+func (s *Signal0) Emit() Error {
+	return s.emit()
+}
+
+// Connect calls fn every time the signal is emitted until the returned
+// connection is disconnected.
+func (s *Signal0) Connect(fn func()) (SignalConnection, Error) {
+	return s.connect(0, func(args []Variant) error {
+		fn()
+		return nil
+	})
+}
+
+func (s *Signal0) signalArgTypes() []reflect.Type {
+	return nil
+}
+
+// Signal1 is a signal with one argument declared as a field of a Go class.
+type Signal1[A any] struct {
+	signalField
+}
+
+// Emit emits the signal from the instance declaring the field.
+func (s *Signal1[A]) Emit(a A) Error {
+	return s.emit(signalValue(a))
+}
+
+// Connect calls fn with the signal arguments every time the signal is emitted
+// until the returned connection is disconnected.
+func (s *Signal1[A]) Connect(fn func(a A)) (SignalConnection, Error) {
+	return s.connect(1, func(args []Variant) error {
+		a, err := signalArg[A](args, 0)
+		if err != nil {
+			return err
+		}
+		defer destroySignalArg(signalValue(a))
+		fn(a)
+		return nil
+	})
+}
+
+func (s *Signal1[A]) signalArgTypes() []reflect.Type {
+	return []reflect.Type{reflect.TypeFor[A]()}
+}
+
+// Signal2 is a signal with two arguments declared as a field of a Go class.
+type Signal2[A, B any] struct {
+	signalField
+}
+
+// Emit emits the signal from the instance declaring the field.
+func (s *Signal2[A, B]) Emit(a A, b B) Error {
+	return s.emit(signalValue(a), signalValue(b))
+}
+
+// Connect calls fn with the signal arguments every time the signal is emitted
+// until the returned connection is disconnected.
+func (s *Signal2[A, B]) Connect(fn func(a A, b B)) (SignalConnection, Error) {
+	return s.connect(2, func(args []Variant) error {
+		a, err := signalArg[A](args, 0)
+		if err != nil {
+			return err
+		}
+		defer destroySignalArg(signalValue(a))
+		b, err := signalArg[B](args, 1)
+		if err != nil {
+			return err
+		}
+		defer destroySignalArg(signalValue(b))
+		fn(a, b)
+		return nil
+	})
+}
+
+func (s *Signal2[A, B]) signalArgTypes() []reflect.Type {
+	return []reflect.Type{reflect.TypeFor[A](), reflect.TypeFor[B]()}
+}
+
+// Signal3 is a signal with three arguments declared as a field of a Go class.
+type Signal3[A, B, C any] struct {
+	signalField
+}
+
+// Emit emits the signal from the instance declaring the field.
+func (s *Signal3[A, B, C]) Emit(a A, b B, c C) Error {
+	return s.emit(signalValue(a), signalValue(b), signalValue(c))
+}
+
+// Connect calls fn with the signal arguments every time the signal is emitted
+// until the returned connection is disconnected.
+func (s *Signal3[A, B, C]) Connect(fn func(a A, b B, c C)) (SignalConnection, Error) {
+	return s.connect(3, func(args []Variant) error {
+		a, err := signalArg[A](args, 0)
+		if err != nil {
+			return err
+		}
+		defer destroySignalArg(signalValue(a))
+		b, err := signalArg[B](args, 1)
+		if err != nil {
+			return err
+		}
+		defer destroySignalArg(signalValue(b))
+		c, err := signalArg[C](args, 2)
+		if err != nil {
+			return err
+		}
+		defer destroySignalArg(signalValue(c))
+		fn(a, b, c)
+		return nil
+	})
+}
+
+func (s *Signal3[A, B, C]) signalArgTypes() []reflect.Type {
+	return []reflect.Type{reflect.TypeFor[A](), reflect.TypeFor[B](), reflect.TypeFor[C]()}
+}
+
+// Signal4 is a signal with four arguments declared as a field of a Go class.
+type Signal4[A, B, C, D any] struct {
+	signalField
+}
+
+// Emit emits the signal from the instance declaring the field.
+func (s *Signal4[A, B, C, D]) Emit(a A, b B, c C, d D) Error {
+	return s.emit(signalValue(a), signalValue(b), signalValue(c), signalValue(d))
+}
+
+// Connect calls fn with the signal arguments every time the signal is emitted
+// until the returned connection is disconnected.
+func (s *Signal4[A, B, C, D]) Connect(fn func(a A, b B, c C, d D)) (SignalConnection, Error) {
+	return s.connect(4, func(args []Variant) error {
+		a, err := signalArg[A](args, 0)
+		if err != nil {
+			return err
+		}
+		defer destroySignalArg(signalValue(a))
+		b, err := signalArg[B](args, 1)
+		if err != nil {
+			return err
+		}
+		defer destroySignalArg(signalValue(b))
+		c, err := signalArg[C](args, 2)
+		if err != nil {
+			return err
+		}
+		defer destroySignalArg(signalValue(c))
+		d, err := signalArg[D](args, 3)
+		if err != nil {
+			return err
+		}
+		defer destroySignalArg(signalValue(d))
+		fn(a, b, c, d)
+		return nil
+	})
+}
+
+func (s *Signal4[A, B, C, D]) signalArgTypes() []reflect.Type {
+	return []reflect.Type{reflect.TypeFor[A](), reflect.TypeFor[B](), reflect.TypeFor[C](), reflect.TypeFor[D]()}
+}
+
+// signalValue keeps the static type of v, so nil interfaces stay typed.
+func signalValue[T any](v T) reflect.Value {
+	return reflect.ValueOf(&v).Elem()
+}
+
+// signalArg decodes argument i for a typed handler. The value is a copy owned
+// by the handler call and released with destroySignalArg once it returns.
+func signalArg[T any](args []Variant, i int) (ret T, err error) {
+	v, err := signalArgFromVariant(args[i], reflect.TypeFor[T]())
+	if err != nil {
+		return ret, fmt.Errorf("argument %d: %w", i, err)
+	}
+	return v.Interface().(T), nil
+}
+
+// destroySignalArg destroys the engine values held by a decoded argument,
+// including the elements of a slice or map.
+func destroySignalArg(v reflect.Value) {
+	switch {
+	case v.Kind() == reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			destroySignalArg(v.Index(i))
+		}
+	case v.Kind() == reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			destroySignalArg(iter.Key())
+			destroySignalArg(iter.Value())
+		}
+	case isDestroyable(v.Type()):
+		p := reflect.New(v.Type())
+		p.Elem().Set(v)
+		p.Interface().(destroyer).Destroy()
+	}
+}
+
+// signalArgToVariant converts a signal argument to a Variant; slices become
+// the packed array or typed Array they are passed as to bound methods. Ref
+// arguments are borrowed rather than handed over to the Variant, as the
+// emitter keeps its reference.
+func signalArgToVariant(v reflect.Value) Variant {
+	var ret Variant
+	switch {
+	case v.Kind() == reflect.Slice:
+		arr := NewArray()
+		defer arr.Destroy()
+		for i := 0; i < v.Len(); i++ {
+			elem := signalArgToVariant(v.Index(i))
+			arr.Append(elem)
+			elem.Destroy()
+		}
+		return sliceArrayToVariant(arr, v.Type())
+	case (v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer) && v.IsNil():
+		return NewVariantNil()
+	case v.Type().Implements(refType):
+		ref := v.Interface().(Ref)
+		if !ref.IsValid() {
+			return NewVariantNil()
+		}
+		ObjectEncoder.EncodeVariantPtrArg(ref.Ptr(), (GDExtensionUninitializedVariantPtr)(ret.NativePtr()))
+	default:
+		GDExtensionVariantPtrFromReflectValue(v, (GDExtensionUninitializedVariantPtr)(ret.NativePtr()))
+	}
+	return ret
+}
+
+func signalArgFromVariant(arg Variant, t reflect.Type) (reflect.Value, error) {
+	switch {
+	case t.Kind() == reflect.Slice:
+		arr, err := sliceArrayFromVariant(arg, t)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		defer arr.Destroy()
+		n := int(arr.Size())
+		out := reflect.MakeSlice(t, n, n)
+		for i := 0; i < n; i++ {
+			elem := arr.Get(int64(i))
+			v, err := signalArgFromVariant(elem, t.Elem())
+			elem.Destroy()
+			if err != nil {
+				destroySignalArg(out)
+				return out, fmt.Errorf("element %d: %w", i, err)
+			}
+			out.Index(i).Set(v)
+		}
+		return out, nil
+	case (t.Kind() == reflect.Interface || t.Kind() == reflect.Pointer) && arg.IsNil():
+		return reflect.Zero(t), nil
+	}
+	return convertVariantToGoTypeReflectValue(arg, t)
+}
+
+// signalParam describes an argument of type t for ClassDBAddSignal like an
+// argument of a bound method: object arguments carry their class name and
+// slices become packed arrays or arrays typed with their element type.
+func signalParam(t reflect.Type, name string) (SignalParam, error) {
+	vt, err := autoVariantType(t)
+	if err != nil {
+		return SignalParam{}, err
+	}
+	p := SignalParam{Type: vt, Name: name}
+	if vt == GDEXTENSION_VARIANT_TYPE_OBJECT {
+		p.ClassName = ObjectClassName(t)
+	}
+	if hint, hintString, ok := reflectTypeHint(vt, t); ok {
+		p.Hint = hint
+		p.HintString = hintString
+	}
+	return p, nil
+}
+
+func variantTypeName(vt GDExtensionVariantType) string {
+	var s String
+	CallFunc_GDExtensionInterfaceVariantGetTypeName(vt, (GDExtensionUninitializedStringPtr)(s.NativePtr()))
+	defer s.Destroy()
+	return s.ToUtf8()
+}
+
+// ClassDBAddTypedSignal adds the signal name declared by the SignalN field
+// fieldName of the class of t; argNames name its arguments and default to
+// arg0, arg1, .... Every instance of the class binds the field, so Emit and
+// Connect act on the signal of that instance.
+func ClassDBAddTypedSignal(t GDClass, fieldName, name string, argNames ...string) {
+	typeName := t.GetClassName()
+	ci, ok := Internal.GDRegisteredGDClasses.Get(typeName)
+	if !ok {
+		log.Panic("Class doesn't exist.", zap.String("class", typeName))
+		return
+	}
+	f, ok := ci.ClassType.FieldByName(fieldName)
+	if !ok || len(f.Index) != 1 {
+		log.Panic("typed signal field not found",
+			zap.String("class", typeName),
+			zap.String("field", fieldName),
+		)
+		return
+	}
+	params, err := typedSignalParams(f.Type, argNames)
+	if err != nil {
+		log.Panic("unable to add typed signal",
+			zap.String("class", typeName),
+			zap.String("field", fieldName),
+			zap.Error(err),
+		)
+		return
+	}
+	ClassDBAddSignal(t, name, params...)
+	ci.SignalFields[name] = f.Index
+}
+
+// typedSignalParams describes the arguments of the SignalN type t.
+func typedSignalParams(t reflect.Type, argNames []string) ([]SignalParam, error) {
+	if !reflect.PointerTo(t).Implements(typedSignalType) {
+		return nil, fmt.Errorf("%v is not a typed signal", t)
+	}
+	argTypes := reflect.New(t).Interface().(typedSignal).signalArgTypes()
+	if len(argNames) == 0 {
+		argNames, _ = autoArgNames("", len(argTypes))
+	} else if len(argNames) != len(argTypes) {
+		return nil, fmt.Errorf("%d argument names given for %d arguments", len(argNames), len(argTypes))
+	}
+	params := make([]SignalParam, len(argTypes))
+	for i, at := range argTypes {
+		p, err := signalParam(at, argNames[i])
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %w", i, err)
+		}
+		params[i] = p
+	}
+	return params, nil
+}
+
+// bindSignalFields binds the typed signal fields of inst, including the ones
+// declared by the Go classes it derives from, to owner.
+func bindSignalFields(ci *ClassInfo, inst reflect.Value, owner Object) {
+	v := inst.Elem()
+	for c := ci; c != nil; c = c.ParentPtr {
+		for name, index := range c.SignalFields {
+			v.FieldByIndex(index).Addr().Interface().(typedSignal).bindSignal(owner, name)
+		}
+		if c.ParentPtr == nil || v.NumField() == 0 {
+			break
+		}
+		// Go classes embed the class they derive from as their first field
+		v = v.Field(0)
+	}
+}
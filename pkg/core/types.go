@@ -34,7 +34,9 @@ type ClassInfo struct {
 	Level                     GDExtensionInitializationLevel
 	MethodMap                 map[string]*MethodBindAndClassMethodInfo
 	VirtualMethodMap          map[string]*MethodBindAndClassMethodInfo
+	ScriptVirtualNameSet      StringSet
 	SignalNameSet             StringSet
+	SignalFields              map[string][]int
 	PropertyNameSet           StringSet
 	ConstantNameSet           StringSet
 	ParentPtr                 *ClassInfo
@@ -42,6 +44,9 @@ type ClassInfo struct {
 	InheritType               reflect.Type
 	PropertyList              []GDExtensionPropertyInfo
 	ValidateProperty          func(*GDExtensionPropertyInfo)
+	NotificationHandlers      []NotificationHandler
+	PropertyDefaults          map[string]PropertyDefault
+	Options                   ClassOptions
 }
 
 func (c *ClassInfo) String() string {
@@ -53,6 +58,26 @@ func (c *ClassInfo) String() string {
 	return sb.String()
 }
 
+// NativeParentName returns the name of the engine class the class derives
+// from, skipping the Go classes in between.
+func (c *ClassInfo) NativeParentName() string {
+	for c.ParentPtr != nil {
+		c = c.ParentPtr
+	}
+	return c.ParentName
+}
+
+// LookupVirtualMethod finds the virtual method name bound by the class or the
+// Go classes it derives from.
+func (c *ClassInfo) LookupVirtualMethod(name string) (*MethodBindAndClassMethodInfo, bool) {
+	for ; c != nil; c = c.ParentPtr {
+		if mb, ok := c.VirtualMethodMap[name]; ok {
+			return mb, true
+		}
+	}
+	return nil, false
+}
+
 func (c *ClassInfo) Destroy() {
 	name := (*StringName)(unsafe.Pointer(c.NameAsStringNamePtr))
 	if name != nil {
@@ -64,6 +89,10 @@ func (c *ClassInfo) Destroy() {
 		parentName.Destroy()
 	}
 
+	for _, d := range c.PropertyDefaults {
+		d.Value.Destroy()
+	}
+
 	// for _, v := range c.VirtualMethodMap {
 	// 	v.ClassMethodInfo.Destroy()
 	// }
@@ -83,25 +112,36 @@ func NewClassInfo(
 ) *ClassInfo {
 	nameStringName := NewStringNameWithLatin1Chars(name)
 	ret := &ClassInfo{
-		Name:                name,
-		NameAsStringNamePtr: nameStringName.AsGDExtensionConstStringNamePtr(),
-		ParentName:          parentName,
-		Level:               level,
-		MethodMap:           map[string]*MethodBindAndClassMethodInfo{},
-		SignalNameSet:       map[string]struct{}{},
-		VirtualMethodMap:    map[string]*MethodBindAndClassMethodInfo{},
-		PropertyNameSet:     map[string]struct{}{},
-		ConstantNameSet:     map[string]struct{}{},
-		ParentPtr:           parentPtr,
-		ClassType:           classType,
-		InheritType:         inheritType,
-		PropertyList:        propertyList,
-		ValidateProperty:    validateProperty,
+		Name:                 name,
+		NameAsStringNamePtr:  nameStringName.AsGDExtensionConstStringNamePtr(),
+		ParentName:           parentName,
+		Level:                level,
+		MethodMap:            map[string]*MethodBindAndClassMethodInfo{},
+		SignalNameSet:        map[string]struct{}{},
+		SignalFields:         map[string][]int{},
+		VirtualMethodMap:     map[string]*MethodBindAndClassMethodInfo{},
+		ScriptVirtualNameSet: map[string]struct{}{},
+		PropertyNameSet:      map[string]struct{}{},
+		ConstantNameSet:      map[string]struct{}{},
+		ParentPtr:            parentPtr,
+		ClassType:            classType,
+		InheritType:          inheritType,
+		PropertyList:         propertyList,
+		ValidateProperty:     validateProperty,
+		NotificationHandlers: newNotificationHandlers(classType),
+		PropertyDefaults:     map[string]PropertyDefault{},
 	}
 	pnr.Pin(ret)
+	if len(propertyList) > 0 {
+		// handed to Godot as is by the get_property_list callback
+		pnr.Pin(unsafe.SliceData(propertyList))
+	}
 	return ret
 }
 
 var (
 	classdbCurrentLevel GDExtensionInitializationLevel = GDEXTENSION_INITIALIZATION_CORE
+	// minimumInitializationLevel is the first level initialized and the last
+	// one deinitialized
+	minimumInitializationLevel GDExtensionInitializationLevel = GDEXTENSION_INITIALIZATION_CORE
 )
@@ -0,0 +1,304 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/constant"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// containerVariantType returns the variant type a Go slice or map type is
+// passed as; the key and element types are checked to be supported.
+func containerVariantType(t reflect.Type) GDExtensionVariantType {
+	if t.Kind() == reflect.Map {
+		ReflectTypeToGDExtensionVariantType(t.Key())
+		ReflectTypeToGDExtensionVariantType(t.Elem())
+		return GDEXTENSION_VARIANT_TYPE_DICTIONARY
+	}
+	if vt, ok := PackedArrayVariantType(t.Elem()); ok {
+		return vt
+	}
+	ReflectTypeToGDExtensionVariantType(t.Elem())
+	return GDEXTENSION_VARIANT_TYPE_ARRAY
+}
+
+// arrayElementType returns the type and class name an Array holding elements
+// of type elem is typed with. Arrays of Variant are left untyped.
+func arrayElementType(elem reflect.Type) (GDExtensionVariantType, string) {
+	vt := ReflectTypeToGDExtensionVariantType(elem)
+	switch vt {
+	case GDEXTENSION_VARIANT_TYPE_VARIANT_MAX:
+		return GDEXTENSION_VARIANT_TYPE_NIL, ""
+	case GDEXTENSION_VARIANT_TYPE_OBJECT:
+		className := ObjectClassName(elem)
+		if len(className) == 0 {
+			className = "Object"
+		}
+		return vt, className
+	}
+	return vt, ""
+}
+
+// arrayTypeHintString returns the PROPERTY_HINT_ARRAY_TYPE hint string of a
+// slice passed as an Array; it is empty for untyped arrays.
+func arrayTypeHintString(t reflect.Type) string {
+	vt, className := arrayElementType(t.Elem())
+	switch {
+	case vt == GDEXTENSION_VARIANT_TYPE_NIL:
+		return ""
+	case len(className) > 0:
+		return className
+	}
+	return variantTypeName(vt)
+}
+
+// reflectContainerToVariant converts a Go slice or map to a new Variant
+// holding a packed array, a typed Array or a Dictionary. Nil slices and maps
+// are converted to empty containers.
+func reflectContainerToVariant(v reflect.Value) Variant {
+	if v.Kind() == reflect.Map {
+		dict := NewDictionary()
+		defer dict.Destroy()
+		iter := v.MapRange()
+		for iter.Next() {
+			key := reflectValueToVariant(iter.Key())
+			value := reflectValueToVariant(iter.Value())
+			dict.Set(key, value)
+			key.Destroy()
+			value.Destroy()
+		}
+		return NewVariantDictionary(dict)
+	}
+	arr := NewArray()
+	defer arr.Destroy()
+	for i := 0; i < v.Len(); i++ {
+		elem := reflectValueToVariant(v.Index(i))
+		arr.Append(elem)
+		elem.Destroy()
+	}
+	return sliceArrayToVariant(arr, v.Type())
+}
+
+// sliceArrayToVariant converts arr, holding the elements of a slice of type
+// t, to a new Variant holding the packed array or typed Array the slice is
+// passed as.
+func sliceArrayToVariant(arr Array, t reflect.Type) Variant {
+	if vt := containerVariantType(t); vt != GDEXTENSION_VARIANT_TYPE_ARRAY {
+		untyped := NewVariantArray(arr)
+		defer untyped.Destroy()
+		ret, err := NewVariantConstructed(vt, untyped)
+		if err != nil {
+			log.Panic("unable to convert slice to packed array",
+				zap.Any("type", t),
+				zap.Error(err),
+			)
+		}
+		return ret
+	}
+	vt, className := arrayElementType(t.Elem())
+	if vt == GDEXTENSION_VARIANT_TYPE_NIL {
+		return NewVariantArray(arr)
+	}
+	cn := NewStringNameWithUtf8Chars(className)
+	defer cn.Destroy()
+	script := NewVariantNil()
+	defer script.Destroy()
+	typed := NewArrayWithArrayInt64StringNameVariant(arr, int64(vt), cn, script)
+	defer typed.Destroy()
+	return NewVariantArray(typed)
+}
+
+// reflectValueToVariant converts an element of a Go slice or map to a new
+// Variant.
+func reflectValueToVariant(v reflect.Value) Variant {
+	var ret Variant
+	if (v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer) && v.IsNil() {
+		return NewVariantNil()
+	}
+	GDExtensionVariantPtrFromReflectValue(v, (GDExtensionUninitializedVariantPtr)(ret.NativePtr()))
+	return ret
+}
+
+// reflectContainerFromVariant converts an Array, a packed array or a
+// Dictionary to a Go slice or map of type t. Slices accept any array
+// whatever the type the slice is passed as; nil converts to a nil slice or
+// map.
+func reflectContainerFromVariant(arg Variant, t reflect.Type) (reflect.Value, error) {
+	if arg.IsNil() {
+		return reflect.Zero(t), nil
+	}
+	if t.Kind() == reflect.Map {
+		return reflectMapFromVariant(arg, t)
+	}
+	arr, err := sliceArrayFromVariant(arg, t)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	defer arr.Destroy()
+	n := int(arr.Size())
+	out := reflect.MakeSlice(t, n, n)
+	for i := 0; i < n; i++ {
+		elem := arr.Get(int64(i))
+		v, err := convertVariantToGoTypeReflectValue(elem, t.Elem())
+		elem.Destroy()
+		if err != nil {
+			return out, fmt.Errorf("element %d: %w", i, err)
+		}
+		out.Index(i).Set(v)
+	}
+	return out, nil
+}
+
+// sliceArrayFromVariant copies the Array or packed array arg holds into a new
+// Array to be converted to a slice of type t.
+func sliceArrayFromVariant(arg Variant, t reflect.Type) (Array, error) {
+	if arg.GetType() == GDEXTENSION_VARIANT_TYPE_ARRAY {
+		return arg.ToArray(), nil
+	}
+	src, err := NewVariantConstructed(GDEXTENSION_VARIANT_TYPE_ARRAY, arg)
+	if err != nil {
+		return Array{}, fmt.Errorf("unable to convert %s to %v: %w",
+			GDExtensionVariantTypeStringMap[arg.GetType()], t, err)
+	}
+	defer src.Destroy()
+	return src.ToArray(), nil
+}
+
+func reflectMapFromVariant(arg Variant, t reflect.Type) (reflect.Value, error) {
+	if arg.GetType() != GDEXTENSION_VARIANT_TYPE_DICTIONARY {
+		return reflect.Value{}, fmt.Errorf("unable to convert %s to %v",
+			GDExtensionVariantTypeStringMap[arg.GetType()], t)
+	}
+	dict := arg.ToDictionary()
+	defer dict.Destroy()
+	keys := dict.Keys()
+	defer keys.Destroy()
+	missing := NewVariantNil()
+	defer missing.Destroy()
+	n := keys.Size()
+	out := reflect.MakeMapWithSize(t, int(n))
+	for i := int64(0); i < n; i++ {
+		key := keys.Get(i)
+		value := dict.Get(key, missing)
+		k, v, err := reflectMapEntryFromVariants(key, value, t)
+		key.Destroy()
+		value.Destroy()
+		if err != nil {
+			return out, fmt.Errorf("entry %d: %w", i, err)
+		}
+		out.SetMapIndex(k, v)
+	}
+	return out, nil
+}
+
+func reflectMapEntryFromVariants(key, value Variant, t reflect.Type) (reflect.Value, reflect.Value, error) {
+	k, err := convertVariantToGoTypeReflectValue(key, t.Key())
+	if err != nil {
+		return k, reflect.Value{}, fmt.Errorf("key: %w", err)
+	}
+	v, err := convertVariantToGoTypeReflectValue(value, t.Elem())
+	if err != nil {
+		return k, v, fmt.Errorf("value: %w", err)
+	}
+	return k, v, nil
+}
+
+// structValueEncoder converts a Go struct type: a TypedArray or
+// TypedDictionary to its engine container and any other struct to a
+// Dictionary of its fields.
+type structValueEncoder interface {
+	ArgumentEncoder
+	DecodeVariant(v *Variant) (reflect.Value, error)
+}
+
+// structValueEncoderFor returns the encoder of the struct type t and the
+// variant type t is passed as.
+func structValueEncoderFor(t reflect.Type) (structValueEncoder, GDExtensionVariantType, error) {
+	if e, ok := TypedContainerEncoderFor(t); ok {
+		return e, e.VariantType(), nil
+	}
+	e, err := StructEncoderFor(t)
+	if err != nil {
+		return nil, GDEXTENSION_VARIANT_TYPE_NIL, err
+	}
+	return e, GDEXTENSION_VARIANT_TYPE_DICTIONARY, nil
+}
+
+// variantFromContainerTypePtr copies the Array, packed array or Dictionary
+// of type vt ptr points to into a new Variant.
+func variantFromContainerTypePtr(vt GDExtensionVariantType, ptr GDExtensionConstTypePtr) Variant {
+	var ret Variant
+	fn := CallFunc_GDExtensionInterfaceGetVariantFromTypeConstructor(vt)
+	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
+		fn,
+		(GDExtensionUninitializedVariantPtr)(ret.NativePtr()),
+		(GDExtensionTypePtr)(ptr),
+	)
+	return ret
+}
+
+// reflectContainerToTypePtr converts a Go slice or map to the Array, packed
+// array or Dictionary rOut points to.
+func reflectContainerToTypePtr(v reflect.Value, rOut GDExtensionUninitializedTypePtr) {
+	ret := reflectContainerToVariant(v)
+	defer ret.Destroy()
+	fn := CallFunc_GDExtensionInterfaceGetVariantToTypeConstructor(ret.GetType())
+	CallFunc_GDExtensionTypeFromVariantConstructorFunc(fn, rOut, ret.NativePtr())
+}
+
+// newReflectTypePropertyInfo is NewSimpleGDExtensionPropertyInfo hinting the
+// element types of typed containers.
+func newReflectTypePropertyInfo(className string, vt GDExtensionVariantType, t reflect.Type) GDExtensionPropertyInfo {
+	if hint, hintString, ok := reflectTypeHint(vt, t); ok {
+		return newHintedGDExtensionPropertyInfo(className, vt, t.Name(), hint, hintString)
+	}
+	return NewSimpleGDExtensionPropertyInfo(className, vt, t.Name())
+}
+
+// reflectTypeHint returns the hint of the element types of a slice passed as a
+// typed Array, a TypedArray or a TypedDictionary; ok is false for other types
+// and untyped containers.
+func reflectTypeHint(vt GDExtensionVariantType, t reflect.Type) (hint PropertyHint, hintString string, ok bool) {
+	if e, typed := TypedContainerEncoderFor(t); typed {
+		return typedContainerHint(e)
+	}
+	if vt == GDEXTENSION_VARIANT_TYPE_ARRAY && t.Kind() == reflect.Slice {
+		if hintString := arrayTypeHintString(t); len(hintString) > 0 {
+			return PROPERTY_HINT_ARRAY_TYPE, hintString, true
+		}
+	}
+	return PROPERTY_HINT_NONE, "", false
+}
+
+// typedContainerHint returns PROPERTY_HINT_ARRAY_TYPE with the element type of
+// a TypedArray or PROPERTY_HINT_DICTIONARY_TYPE with the "key;value" types
+// of a TypedDictionary.
+func typedContainerHint(e *TypedContainerEncoder) (PropertyHint, string, bool) {
+	elems := e.ElementTypes()
+	names := make([]string, len(elems))
+	typed := false
+	for i, et := range elems {
+		switch {
+		case et.VariantType == GDEXTENSION_VARIANT_TYPE_NIL:
+			names[i] = "Variant"
+			continue
+		case len(et.ClassName) > 0:
+			names[i] = et.ClassName
+		default:
+			names[i] = variantTypeName(et.VariantType)
+		}
+		typed = true
+	}
+	switch {
+	case !typed:
+		return PROPERTY_HINT_NONE, "", false
+	case e.VariantType() == GDEXTENSION_VARIANT_TYPE_ARRAY:
+		return PROPERTY_HINT_ARRAY_TYPE, names[0], true
+	}
+	return PROPERTY_HINT_DICTIONARY_TYPE, strings.Join(names, ";"), true
+}
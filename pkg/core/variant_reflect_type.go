@@ -25,179 +25,38 @@ func ReflectTypeToGDExtensionVariantType(t reflect.Type) GDExtensionVariantType
 		return GDEXTENSION_VARIANT_TYPE_INT
 	case reflect.Float32, reflect.Float64:
 		return GDEXTENSION_VARIANT_TYPE_FLOAT
+	case reflect.UnsafePointer:
+		// native pointers are reported as integers like in the engine
+		return GDEXTENSION_VARIANT_TYPE_INT
 	case reflect.Array:
 		elemType := t.Elem()
 		// for godot types, we expect to see uint8 arrays
 		switch elemType.Kind() {
 		case reflect.Uint8:
-			zero := reflect.Zero(t)
-			inst := zero.Interface()
-			switch inst.(type) {
-			case String:
-				return GDEXTENSION_VARIANT_TYPE_STRING
-			case Vector2:
-				return GDEXTENSION_VARIANT_TYPE_VECTOR2
-			case Vector2i:
-				return GDEXTENSION_VARIANT_TYPE_VECTOR2I
-			case Rect2:
-				return GDEXTENSION_VARIANT_TYPE_RECT2
-			case Rect2i:
-				return GDEXTENSION_VARIANT_TYPE_RECT2I
-			case Vector3:
-				return GDEXTENSION_VARIANT_TYPE_VECTOR3
-			case Vector3i:
-				return GDEXTENSION_VARIANT_TYPE_VECTOR3I
-			case Vector4:
-				return GDEXTENSION_VARIANT_TYPE_VECTOR4
-			case Vector4i:
-				return GDEXTENSION_VARIANT_TYPE_VECTOR4I
-			case Transform2D:
-				return GDEXTENSION_VARIANT_TYPE_TRANSFORM2D
-			case Plane:
-				return GDEXTENSION_VARIANT_TYPE_PLANE
-			case Quaternion:
-				return GDEXTENSION_VARIANT_TYPE_QUATERNION
-			case AABB:
-				return GDEXTENSION_VARIANT_TYPE_AABB
-			case Basis:
-				return GDEXTENSION_VARIANT_TYPE_BASIS
-			case Transform3D:
-				return GDEXTENSION_VARIANT_TYPE_TRANSFORM3D
-			case Color:
-				return GDEXTENSION_VARIANT_TYPE_COLOR
-			case StringName:
-				return GDEXTENSION_VARIANT_TYPE_STRING_NAME
-			case NodePath:
-				return GDEXTENSION_VARIANT_TYPE_NODE_PATH
-			case RID:
-				return GDEXTENSION_VARIANT_TYPE_RID
-			case Callable:
-				return GDEXTENSION_VARIANT_TYPE_CALLABLE
-			case Signal:
-				return GDEXTENSION_VARIANT_TYPE_SIGNAL
-			case Dictionary:
-				return GDEXTENSION_VARIANT_TYPE_DICTIONARY
-			case Array:
-				return GDEXTENSION_VARIANT_TYPE_ARRAY
-			case PackedByteArray:
-				return GDEXTENSION_VARIANT_TYPE_PACKED_BYTE_ARRAY
-			case PackedInt32Array:
-				return GDEXTENSION_VARIANT_TYPE_PACKED_INT32_ARRAY
-			case PackedInt64Array:
-				return GDEXTENSION_VARIANT_TYPE_PACKED_INT64_ARRAY
-			case PackedFloat32Array:
-				return GDEXTENSION_VARIANT_TYPE_PACKED_FLOAT32_ARRAY
-			case PackedFloat64Array:
-				return GDEXTENSION_VARIANT_TYPE_PACKED_FLOAT64_ARRAY
-			case PackedStringArray:
-				return GDEXTENSION_VARIANT_TYPE_PACKED_STRING_ARRAY
-			case PackedVector2Array:
-				return GDEXTENSION_VARIANT_TYPE_PACKED_VECTOR2_ARRAY
-			case PackedVector3Array:
-				return GDEXTENSION_VARIANT_TYPE_PACKED_VECTOR3_ARRAY
-			case PackedColorArray:
-				return GDEXTENSION_VARIANT_TYPE_PACKED_COLOR_ARRAY
-			case PackedVector4Array:
-				return GDEXTENSION_VARIANT_TYPE_PACKED_VECTOR4_ARRAY
-			case Variant:
+			if t == reflect.TypeFor[Variant]() {
 				return GDEXTENSION_VARIANT_TYPE_VARIANT_MAX
-			default:
+			}
+			_, vt, ok := EncoderForType(t)
+			if !ok {
 				log.Panic("unhandled go array type", zap.Any("type", t))
 			}
+			return vt
 		default:
 			log.Panic("unhandled go array element type",
 				zap.Any("type", t),
 				zap.Any("elem_type", elemType),
 			)
 		}
-	case reflect.Slice:
-		elemType := t.Elem()
-		zero := reflect.Zero(elemType)
-		inst := zero.Interface()
-		switch inst.(type) {
-		case String:
-			return GDEXTENSION_VARIANT_TYPE_STRING
-		case Vector2:
-			return GDEXTENSION_VARIANT_TYPE_VECTOR2
-		case Vector2i:
-			return GDEXTENSION_VARIANT_TYPE_VECTOR2I
-		case Rect2:
-			return GDEXTENSION_VARIANT_TYPE_RECT2
-		case Rect2i:
-			return GDEXTENSION_VARIANT_TYPE_RECT2I
-		case Vector3:
-			return GDEXTENSION_VARIANT_TYPE_VECTOR3
-		case Vector3i:
-			return GDEXTENSION_VARIANT_TYPE_VECTOR3I
-		case Vector4:
-			return GDEXTENSION_VARIANT_TYPE_VECTOR4
-		case Vector4i:
-			return GDEXTENSION_VARIANT_TYPE_VECTOR4I
-		case Transform2D:
-			return GDEXTENSION_VARIANT_TYPE_TRANSFORM2D
-		case Plane:
-			return GDEXTENSION_VARIANT_TYPE_PLANE
-		case Quaternion:
-			return GDEXTENSION_VARIANT_TYPE_QUATERNION
-		case AABB:
-			return GDEXTENSION_VARIANT_TYPE_AABB
-		case Basis:
-			return GDEXTENSION_VARIANT_TYPE_BASIS
-		case Transform3D:
-			return GDEXTENSION_VARIANT_TYPE_TRANSFORM3D
-		case Color:
-			return GDEXTENSION_VARIANT_TYPE_COLOR
-		case StringName:
-			return GDEXTENSION_VARIANT_TYPE_STRING_NAME
-		case NodePath:
-			return GDEXTENSION_VARIANT_TYPE_NODE_PATH
-		case RID:
-			return GDEXTENSION_VARIANT_TYPE_RID
-		case Callable:
-			return GDEXTENSION_VARIANT_TYPE_CALLABLE
-		case Signal:
-			return GDEXTENSION_VARIANT_TYPE_SIGNAL
-		case Dictionary:
-			return GDEXTENSION_VARIANT_TYPE_DICTIONARY
-		case Array:
-			return GDEXTENSION_VARIANT_TYPE_ARRAY
-		case PackedByteArray:
-			return GDEXTENSION_VARIANT_TYPE_PACKED_BYTE_ARRAY
-		case PackedInt32Array:
-			return GDEXTENSION_VARIANT_TYPE_PACKED_INT32_ARRAY
-		case PackedInt64Array:
-			return GDEXTENSION_VARIANT_TYPE_PACKED_INT64_ARRAY
-		case PackedFloat32Array:
-			return GDEXTENSION_VARIANT_TYPE_PACKED_FLOAT32_ARRAY
-		case PackedFloat64Array:
-			return GDEXTENSION_VARIANT_TYPE_PACKED_FLOAT64_ARRAY
-		case PackedStringArray:
-			return GDEXTENSION_VARIANT_TYPE_PACKED_STRING_ARRAY
-		case PackedVector2Array:
-			return GDEXTENSION_VARIANT_TYPE_PACKED_VECTOR2_ARRAY
-		case PackedVector3Array:
-			return GDEXTENSION_VARIANT_TYPE_PACKED_VECTOR3_ARRAY
-		case PackedColorArray:
-			return GDEXTENSION_VARIANT_TYPE_PACKED_COLOR_ARRAY
-		case PackedVector4Array:
-			return GDEXTENSION_VARIANT_TYPE_PACKED_VECTOR4_ARRAY
-		case Variant:
-			return GDEXTENSION_VARIANT_TYPE_VARIANT_MAX
-		default:
-			log.Panic("unhandled go slice element type",
-				zap.Any("type", t),
-				zap.Any("elem_type", elemType),
-			)
-		}
+	case reflect.Slice, reflect.Map:
+		return containerVariantType(t)
 	case reflect.String:
 		return GDEXTENSION_VARIANT_TYPE_STRING
 	case reflect.Struct:
-		zero := reflect.Zero(t)
-		inst := zero.Interface()
-		switch inst.(type) {
-		default:
-			log.Panic("unhandled go struct", zap.Any("type", t))
+		_, vt, err := structValueEncoderFor(t)
+		if err != nil {
+			log.Panic("unhandled go struct", zap.Any("type", t), zap.Error(err))
 		}
+		return vt
 	case reflect.Pointer:
 		zero := reflect.Zero(t)
 		inst := zero.Interface()
@@ -245,7 +104,7 @@ func ReflectTypeToGDExtensionVariantType(t reflect.Type) GDExtensionVariantType
 				zap.Any("type", t),
 			)
 		}
-	case reflect.Map, reflect.Chan, reflect.Func, reflect.Uintptr, reflect.Complex64, reflect.Complex128, reflect.UnsafePointer:
+	case reflect.Chan, reflect.Func, reflect.Uintptr, reflect.Complex64, reflect.Complex128:
 		log.Panic("unhandled reflected go kind", zap.Any("type", t))
 	default:
 		log.Panic("unhandled go kind", zap.Any("type", t))
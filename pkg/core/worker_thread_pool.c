@@ -0,0 +1,16 @@
+#include <godot/gdextension_interface.h>
+#include "worker_thread_pool.h"
+#include "stacktrace.h"
+
+extern void GoCallback_WorkerThreadPoolTask(void *p_userdata);
+extern void GoCallback_WorkerThreadPoolGroupTask(void *p_userdata, uint32_t p_index);
+
+void cgo_worker_thread_pool_task(void *p_userdata) {
+	printStacktrace();
+	GoCallback_WorkerThreadPoolTask(p_userdata);
+}
+
+void cgo_worker_thread_pool_group_task(void *p_userdata, uint32_t p_index) {
+	printStacktrace();
+	GoCallback_WorkerThreadPoolGroupTask(p_userdata, p_index);
+}
@@ -0,0 +1,131 @@
+package core
+
+// #include <godot/gdextension_interface.h>
+// #include "worker_thread_pool.h"
+import "C"
+import (
+	"fmt"
+	"math"
+	"runtime/cgo"
+	"sync/atomic"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	. "github.com/godot-go/godot-go/pkg/util"
+	"go.uber.org/zap"
+)
+
+// TaskID identifies a task of the engine's WorkerThreadPool. GDScript waits
+// for it with WorkerThreadPool.wait_for_task_completion or
+// wait_for_group_task_completion.
+type TaskID int64
+
+// groupTask is the userdata of a task submitted by SubmitGroupTask; the
+// handle is deleted after the last element runs.
+type groupTask struct {
+	fn        func(index uint32)
+	remaining atomic.Int32
+}
+
+// SubmitTask runs fn on one of the engine's worker threads. fn must not call
+// engine APIs that are restricted to the main thread; use RunOnMain instead.
+func SubmitTask(fn func(), highPriority bool, description string) TaskID {
+	pool := workerThreadPool()
+	desc := NewStringWithUtf8Chars(description)
+	defer desc.Destroy()
+	handle := cgo.NewHandle(fn)
+	id := CallFunc_GDExtensionInterfaceWorkerThreadPoolAddNativeTask(
+		pool,
+		(GDExtensionWorkerThreadPoolTask)(C.cgo_worker_thread_pool_task),
+		unsafe.Pointer(handle),
+		(GDExtensionBool)(BoolToUint8(highPriority)),
+		(GDExtensionConstStringPtr)(desc.NativeConstPtr()),
+	)
+	return TaskID(id)
+}
+
+// SubmitGroupTask runs fn once for every index in [0, elements) spread over
+// tasks worker threads; a negative tasks lets the engine pick the number of
+// threads. Like SubmitTask, fn must not call engine APIs that are restricted
+// to the main thread.
+func SubmitGroupTask(fn func(index uint32), elements, tasks int, highPriority bool, description string) TaskID {
+	if elements <= 0 || elements > math.MaxInt32 {
+		log.Panic("group task elements must be in [1, MaxInt32]",
+			zap.Int("elements", elements),
+		)
+	}
+	if tasks > math.MaxInt32 {
+		log.Panic("group task tasks must not exceed MaxInt32",
+			zap.Int("tasks", tasks),
+		)
+	}
+	if tasks < 0 {
+		tasks = -1
+	}
+	pool := workerThreadPool()
+	desc := NewStringWithUtf8Chars(description)
+	defer desc.Destroy()
+	task := &groupTask{fn: fn}
+	task.remaining.Store(int32(elements))
+	handle := cgo.NewHandle(task)
+	id := CallFunc_GDExtensionInterfaceWorkerThreadPoolAddNativeGroupTask(
+		pool,
+		(GDExtensionWorkerThreadPoolGroupTask)(C.cgo_worker_thread_pool_group_task),
+		unsafe.Pointer(handle),
+		int32(elements),
+		int32(tasks),
+		(GDExtensionBool)(BoolToUint8(highPriority)),
+		(GDExtensionConstStringPtr)(desc.NativeConstPtr()),
+	)
+	return TaskID(id)
+}
+
+func workerThreadPool() GDExtensionObjectPtr {
+	pool := GetSingleton("WorkerThreadPool")
+	if pool == nil {
+		log.Panic("unable to retrieve WorkerThreadPool singleton")
+	}
+	return pool
+}
+
+// runTask keeps a panic in fn from crashing the worker thread.
+func runTask(fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Error("worker thread pool task panicked",
+				zap.String("panic", fmt.Sprint(r)),
+			)
+		}
+	}()
+	fn()
+}
+
+//export GoCallback_WorkerThreadPoolTask
+func GoCallback_WorkerThreadPoolTask(pUserdata unsafe.Pointer) {
+	handle := cgo.Handle(pUserdata)
+	defer handle.Delete()
+	fn, ok := handle.Value().(func())
+	if !ok {
+		log.Panic("unable to retrieve task userdata")
+	}
+	runTask(fn)
+}
+
+//export GoCallback_WorkerThreadPoolGroupTask
+func GoCallback_WorkerThreadPoolGroupTask(pUserdata unsafe.Pointer, pIndex C.uint32_t) {
+	handle := cgo.Handle(pUserdata)
+	task, ok := handle.Value().(*groupTask)
+	if !ok {
+		log.Panic("unable to retrieve group task userdata")
+	}
+	defer func() {
+		if task.remaining.Add(-1) == 0 {
+			handle.Delete()
+		}
+	}()
+	runTask(func() {
+		task.fn(uint32(pIndex))
+	})
+}
@@ -0,0 +1,9 @@
+#ifndef CGO_GODOT_GO_WORKER_THREAD_POOL_H
+#define CGO_GODOT_GO_WORKER_THREAD_POOL_H
+
+#include <godot/gdextension_interface.h>
+
+void cgo_worker_thread_pool_task(void *p_userdata);
+void cgo_worker_thread_pool_group_task(void *p_userdata, uint32_t p_index);
+
+#endif
@@ -68,3 +68,63 @@ func (m *GDExtensionClassCreationInfo4) Destroy() {
 		C.free(cm.class_userdata)
 	}
 }
+
+// NewGDExtensionClassCreationInfo5 returns the info passed to
+// classdb_register_extension_class5; the struct is unchanged from version 4.
+func NewGDExtensionClassCreationInfo5(
+	isVirtual GDExtensionBool,
+	isAbstract GDExtensionBool,
+	isExposed GDExtensionBool,
+	isRuntime GDExtensionBool,
+	iconPath GDExtensionConstStringPtr,
+	setFunc GDExtensionClassSet,
+	getFunc GDExtensionClassGet,
+	getPropertyListFunc GDExtensionClassGetPropertyList,
+	freePropertyListFunc GDExtensionClassFreePropertyList2,
+	propertyCanRevertFunc GDExtensionClassPropertyCanRevert,
+	propertyGetRevertFunc GDExtensionClassPropertyGetRevert,
+	validatePropertyFunc GDExtensionClassValidateProperty,
+	notificationFunc GDExtensionClassNotification2,
+	toStringFunc GDExtensionClassToString,
+	referenceFunc GDExtensionClassReference,
+	unreferenceFunc GDExtensionClassUnreference,
+	createInstanceFunc GDExtensionClassCreateInstance2,
+	freeInstanceFunc GDExtensionClassFreeInstance,
+	recreateInstanceFunc GDExtensionClassRecreateInstance,
+	getVirtualCallDataFunc GDExtensionClassGetVirtualCallData2,
+	callVirtualFunc GDExtensionClassCallVirtualWithData,
+	classUserdata unsafe.Pointer,
+) GDExtensionClassCreationInfo5 {
+	info := NewGDExtensionClassCreationInfo4(
+		isVirtual,
+		isAbstract,
+		isExposed,
+		isRuntime,
+		iconPath,
+		setFunc,
+		getFunc,
+		getPropertyListFunc,
+		freePropertyListFunc,
+		propertyCanRevertFunc,
+		propertyGetRevertFunc,
+		validatePropertyFunc,
+		notificationFunc,
+		toStringFunc,
+		referenceFunc,
+		unreferenceFunc,
+		createInstanceFunc,
+		freeInstanceFunc,
+		recreateInstanceFunc,
+		getVirtualCallDataFunc,
+		callVirtualFunc,
+		classUserdata,
+	)
+	return (GDExtensionClassCreationInfo5)(info)
+}
+
+func (m *GDExtensionClassCreationInfo5) Destroy() {
+	cm := (*C.GDExtensionClassCreationInfo5)(m)
+	if cm.class_userdata != nil {
+		C.free(cm.class_userdata)
+	}
+}
@@ -91,3 +91,31 @@ func (m *GDExtensionClassMethodInfo) Destroy() {
 		(*GDExtensionPropertyInfo)(cm.arguments_info).Destroy()
 	}
 }
+
+func NewGDExtensionClassVirtualMethodInfo(
+	name GDExtensionConstStringNamePtr,
+	methodFlags uint32,
+	returnValue GDExtensionPropertyInfo,
+	returnValueMetadata GDExtensionClassMethodArgumentMetadata,
+	argumentCount uint32,
+	arguments *GDExtensionPropertyInfo,
+	argumentsMetadata *GDExtensionClassMethodArgumentMetadata,
+) *GDExtensionClassVirtualMethodInfo {
+	ret := (*GDExtensionClassVirtualMethodInfo)(&C.GDExtensionClassVirtualMethodInfo{
+		name: (C.GDExtensionStringNamePtr)(name),
+
+		// Bitfield of `GDExtensionClassMethodFlags`.
+		method_flags: (C.uint32_t)(methodFlags),
+
+		/* A return value of type NIL declares a method without return value. */
+		return_value:          (C.GDExtensionPropertyInfo)(returnValue),
+		return_value_metadata: (C.GDExtensionClassMethodArgumentMetadata)(returnValueMetadata),
+
+		/* `arguments` and `arguments_metadata` are array of size `argument_count`. */
+		argument_count:     (C.uint32_t)(argumentCount),
+		arguments:          (*C.GDExtensionPropertyInfo)(arguments),
+		arguments_metadata: (*C.GDExtensionClassMethodArgumentMetadata)(argumentsMetadata),
+	})
+	pnr.Pin(ret)
+	return ret
+}
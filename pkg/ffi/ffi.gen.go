@@ -363,7 +363,7 @@ func (x *GDExtensionInterface) LoadProcAddresses(
 	x.ClassdbRegisterExtensionClass2 = (GDExtensionInterfaceClassdbRegisterExtensionClass2)(LoadProcAddress("classdb_register_extension_class2"))
 	x.ClassdbRegisterExtensionClass3 = (GDExtensionInterfaceClassdbRegisterExtensionClass3)(LoadProcAddress("classdb_register_extension_class3"))
 	x.ClassdbRegisterExtensionClass4 = (GDExtensionInterfaceClassdbRegisterExtensionClass4)(LoadProcAddress("classdb_register_extension_class4"))
-	x.ClassdbRegisterExtensionClass5 = (GDExtensionInterfaceClassdbRegisterExtensionClass5)(LoadProcAddress("classdb_register_extension_class_5"))
+	x.ClassdbRegisterExtensionClass5 = (GDExtensionInterfaceClassdbRegisterExtensionClass5)(LoadProcAddress("classdb_register_extension_class5"))
 	x.ClassdbRegisterExtensionClassMethod = (GDExtensionInterfaceClassdbRegisterExtensionClassMethod)(LoadProcAddress("classdb_register_extension_class_method"))
 	x.ClassdbRegisterExtensionClassVirtualMethod = (GDExtensionInterfaceClassdbRegisterExtensionClassVirtualMethod)(LoadProcAddress("classdb_register_extension_class_virtual_method"))
 	x.ClassdbRegisterExtensionClassIntegerConstant = (GDExtensionInterfaceClassdbRegisterExtensionClassIntegerConstant)(LoadProcAddress("classdb_register_extension_class_integer_constant"))
@@ -30,6 +30,18 @@ func NewGDExtensionInstanceBindingCallbacks(
 	}
 }
 
+func NewGDExtensionMainLoopCallbacks(
+	startupFunc GDExtensionMainLoopStartupCallback,
+	shutdownFunc GDExtensionMainLoopShutdownCallback,
+	frameFunc GDExtensionMainLoopFrameCallback,
+) GDExtensionMainLoopCallbacks {
+	return GDExtensionMainLoopCallbacks{
+		startup_func:  (C.GDExtensionMainLoopStartupCallback)(startupFunc),
+		shutdown_func: (C.GDExtensionMainLoopShutdownCallback)(shutdownFunc),
+		frame_func:    (C.GDExtensionMainLoopFrameCallback)(frameFunc),
+	}
+}
+
 func (e *GDExtensionInitialization) SetCallbacks(
 	initCallback *[0]byte,
 	deinitCallback *[0]byte,
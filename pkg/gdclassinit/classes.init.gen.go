@@ -6822,6 +6822,1607 @@ func RegisterEngineClasses() {
 	GDNativeConstructors.Set("XRVRS", NewGDExtensionClassFromXRVRSOwner)
 	GDNativeConstructors.Set("ZIPPacker", NewGDExtensionClassFromZIPPackerOwner)
 	GDNativeConstructors.Set("ZIPReader", NewGDExtensionClassFromZIPReaderOwner)
+	GDEngineVirtualMethods.Clear()
+	GDEngineVirtualMethods.Set("AStar2D", []EngineVirtualMethod{
+		NewEngineVirtualMethod[AStar2DFilterNeighborVirtual]("_filter_neighbor", "from_id", "neighbor_id"),
+		NewEngineVirtualMethod[AStar2DEstimateCostVirtual]("_estimate_cost", "from_id", "end_id"),
+		NewEngineVirtualMethod[AStar2DComputeCostVirtual]("_compute_cost", "from_id", "to_id"),
+	})
+	GDEngineVirtualMethods.Set("AStar3D", []EngineVirtualMethod{
+		NewEngineVirtualMethod[AStar3DFilterNeighborVirtual]("_filter_neighbor", "from_id", "neighbor_id"),
+		NewEngineVirtualMethod[AStar3DEstimateCostVirtual]("_estimate_cost", "from_id", "end_id"),
+		NewEngineVirtualMethod[AStar3DComputeCostVirtual]("_compute_cost", "from_id", "to_id"),
+	})
+	GDEngineVirtualMethods.Set("AStarGrid2D", []EngineVirtualMethod{
+		NewEngineVirtualMethod[AStarGrid2DEstimateCostVirtual]("_estimate_cost", "from_id", "end_id"),
+		NewEngineVirtualMethod[AStarGrid2DComputeCostVirtual]("_compute_cost", "from_id", "to_id"),
+	})
+	GDEngineVirtualMethods.Set("AnimationMixer", []EngineVirtualMethod{
+		NewEngineVirtualMethod[AnimationMixerPostProcessKeyValueVirtual]("_post_process_key_value", "animation", "track", "value", "object_id", "object_sub_idx"),
+	})
+	GDEngineVirtualMethods.Set("AnimationNode", []EngineVirtualMethod{
+		NewEngineVirtualMethod[AnimationNodeGetChildNodesVirtual]("_get_child_nodes"),
+		NewEngineVirtualMethod[AnimationNodeGetParameterListVirtual]("_get_parameter_list"),
+		NewEngineVirtualMethod[AnimationNodeGetChildByNameVirtual]("_get_child_by_name", "name"),
+		NewEngineVirtualMethod[AnimationNodeGetParameterDefaultValueVirtual]("_get_parameter_default_value", "parameter"),
+		NewEngineVirtualMethod[AnimationNodeIsParameterReadOnlyVirtual]("_is_parameter_read_only", "parameter"),
+		NewEngineVirtualMethod[AnimationNodeProcessVirtual]("_process", "time", "seek", "is_external_seeking", "test_only"),
+		NewEngineVirtualMethod[AnimationNodeGetCaptionVirtual]("_get_caption"),
+		NewEngineVirtualMethod[AnimationNodeHasFilterVirtual]("_has_filter"),
+	})
+	GDEngineVirtualMethods.Set("AnimationNodeExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[AnimationNodeExtensionProcessAnimationNodeVirtual]("_process_animation_node", "playback_info", "test_only"),
+	})
+	GDEngineVirtualMethods.Set("AudioEffect", []EngineVirtualMethod{
+		NewEngineVirtualMethod[AudioEffectInstantiateVirtual]("_instantiate"),
+	})
+	GDEngineVirtualMethods.Set("AudioEffectInstance", []EngineVirtualMethod{
+		NewEngineVirtualMethod[AudioEffectInstanceProcessVirtual]("_process", "src_buffer", "dst_buffer", "frame_count"),
+		NewEngineVirtualMethod[AudioEffectInstanceProcessSilenceVirtual]("_process_silence"),
+	})
+	GDEngineVirtualMethods.Set("AudioStream", []EngineVirtualMethod{
+		NewEngineVirtualMethod[AudioStreamInstantiatePlaybackVirtual]("_instantiate_playback"),
+		NewEngineVirtualMethod[AudioStreamGetStreamNameVirtual]("_get_stream_name"),
+		NewEngineVirtualMethod[AudioStreamGetLengthVirtual]("_get_length"),
+		NewEngineVirtualMethod[AudioStreamIsMonophonicVirtual]("_is_monophonic"),
+		NewEngineVirtualMethod[AudioStreamGetBpmVirtual]("_get_bpm"),
+		NewEngineVirtualMethod[AudioStreamGetBeatCountVirtual]("_get_beat_count"),
+		NewEngineVirtualMethod[AudioStreamGetTagsVirtual]("_get_tags"),
+		NewEngineVirtualMethod[AudioStreamGetParameterListVirtual]("_get_parameter_list"),
+		NewEngineVirtualMethod[AudioStreamHasLoopVirtual]("_has_loop"),
+		NewEngineVirtualMethod[AudioStreamGetBarBeatsVirtual]("_get_bar_beats"),
+	})
+	GDEngineVirtualMethods.Set("AudioStreamPlayback", []EngineVirtualMethod{
+		NewEngineVirtualMethod[AudioStreamPlaybackStartVirtual]("_start", "from_pos"),
+		NewEngineVirtualMethod[AudioStreamPlaybackStopVirtual]("_stop"),
+		NewEngineVirtualMethod[AudioStreamPlaybackIsPlayingVirtual]("_is_playing"),
+		NewEngineVirtualMethod[AudioStreamPlaybackGetLoopCountVirtual]("_get_loop_count"),
+		NewEngineVirtualMethod[AudioStreamPlaybackGetPlaybackPositionVirtual]("_get_playback_position"),
+		NewEngineVirtualMethod[AudioStreamPlaybackSeekVirtual]("_seek", "position"),
+		NewEngineVirtualMethod[AudioStreamPlaybackMixVirtual]("_mix", "buffer", "rate_scale", "frames"),
+		NewEngineVirtualMethod[AudioStreamPlaybackTagUsedStreamsVirtual]("_tag_used_streams"),
+		NewEngineVirtualMethod[AudioStreamPlaybackSetParameterVirtual]("_set_parameter", "name", "value"),
+		NewEngineVirtualMethod[AudioStreamPlaybackGetParameterVirtual]("_get_parameter", "name"),
+	})
+	GDEngineVirtualMethods.Set("AudioStreamPlaybackResampled", []EngineVirtualMethod{
+		NewEngineVirtualMethod[AudioStreamPlaybackResampledMixResampledVirtual]("_mix_resampled", "dst_buffer", "frame_count"),
+		NewEngineVirtualMethod[AudioStreamPlaybackResampledGetStreamSamplingRateVirtual]("_get_stream_sampling_rate"),
+	})
+	GDEngineVirtualMethods.Set("BaseButton", []EngineVirtualMethod{
+		NewEngineVirtualMethod[BaseButtonPressedVirtual]("_pressed"),
+		NewEngineVirtualMethod[BaseButtonToggledVirtual]("_toggled", "toggled_on"),
+	})
+	GDEngineVirtualMethods.Set("CameraFeed", []EngineVirtualMethod{
+		NewEngineVirtualMethod[CameraFeedActivateFeedVirtual]("_activate_feed"),
+		NewEngineVirtualMethod[CameraFeedDeactivateFeedVirtual]("_deactivate_feed"),
+	})
+	GDEngineVirtualMethods.Set("CanvasItem", []EngineVirtualMethod{
+		NewEngineVirtualMethod[CanvasItemDrawVirtual]("_draw"),
+	})
+	GDEngineVirtualMethods.Set("CodeEdit", []EngineVirtualMethod{
+		NewEngineVirtualMethod[CodeEditConfirmCodeCompletionVirtual]("_confirm_code_completion", "replace"),
+		NewEngineVirtualMethod[CodeEditRequestCodeCompletionVirtual]("_request_code_completion", "force"),
+		NewEngineVirtualMethod[CodeEditFilterCodeCompletionCandidatesVirtual]("_filter_code_completion_candidates", "candidates"),
+	})
+	GDEngineVirtualMethods.Set("CollisionObject2D", []EngineVirtualMethod{
+		NewEngineVirtualMethod[CollisionObject2DInputEventVirtual]("_input_event", "viewport", "event", "shape_idx"),
+		NewEngineVirtualMethod[CollisionObject2DMouseEnterVirtual]("_mouse_enter"),
+		NewEngineVirtualMethod[CollisionObject2DMouseExitVirtual]("_mouse_exit"),
+		NewEngineVirtualMethod[CollisionObject2DMouseShapeEnterVirtual]("_mouse_shape_enter", "shape_idx"),
+		NewEngineVirtualMethod[CollisionObject2DMouseShapeExitVirtual]("_mouse_shape_exit", "shape_idx"),
+	})
+	GDEngineVirtualMethods.Set("CollisionObject3D", []EngineVirtualMethod{
+		NewEngineVirtualMethod[CollisionObject3DInputEventVirtual]("_input_event", "camera", "event", "event_position", "normal", "shape_idx"),
+		NewEngineVirtualMethod[CollisionObject3DMouseEnterVirtual]("_mouse_enter"),
+		NewEngineVirtualMethod[CollisionObject3DMouseExitVirtual]("_mouse_exit"),
+	})
+	GDEngineVirtualMethods.Set("CompositorEffect", []EngineVirtualMethod{
+		NewEngineVirtualMethod[CompositorEffectRenderCallbackVirtual]("_render_callback", "effect_callback_type", "render_data"),
+	})
+	GDEngineVirtualMethods.Set("Container", []EngineVirtualMethod{
+		NewEngineVirtualMethod[ContainerGetAllowedSizeFlagsHorizontalVirtual]("_get_allowed_size_flags_horizontal"),
+		NewEngineVirtualMethod[ContainerGetAllowedSizeFlagsVerticalVirtual]("_get_allowed_size_flags_vertical"),
+	})
+	GDEngineVirtualMethods.Set("Control", []EngineVirtualMethod{
+		NewEngineVirtualMethod[ControlHasPointVirtual]("_has_point", "point"),
+		NewEngineVirtualMethod[ControlStructuredTextParserVirtual]("_structured_text_parser", "args", "text"),
+		NewEngineVirtualMethod[ControlGetMinimumSizeVirtual]("_get_minimum_size"),
+		NewEngineVirtualMethod[ControlGetTooltipVirtual]("_get_tooltip", "at_position"),
+		NewEngineVirtualMethod[ControlGetDragDataVirtual]("_get_drag_data", "at_position"),
+		NewEngineVirtualMethod[ControlCanDropDataVirtual]("_can_drop_data", "at_position", "data"),
+		NewEngineVirtualMethod[ControlDropDataVirtual]("_drop_data", "at_position", "data"),
+		NewEngineVirtualMethod[ControlMakeCustomTooltipVirtual]("_make_custom_tooltip", "for_text"),
+		NewEngineVirtualMethod[ControlAccessibilityGetContextualInfoVirtual]("_accessibility_get_contextual_info"),
+		NewEngineVirtualMethod[ControlGetAccessibilityContainerNameVirtual]("_get_accessibility_container_name", "node"),
+		NewEngineVirtualMethod[ControlGuiInputVirtual]("_gui_input", "event"),
+	})
+	GDEngineVirtualMethods.Set("EditorContextMenuPlugin", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorContextMenuPluginPopupMenuVirtual]("_popup_menu", "paths"),
+	})
+	GDEngineVirtualMethods.Set("EditorDebuggerPlugin", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorDebuggerPluginSetupSessionVirtual]("_setup_session", "session_id"),
+		NewEngineVirtualMethod[EditorDebuggerPluginHasCaptureVirtual]("_has_capture", "capture"),
+		NewEngineVirtualMethod[EditorDebuggerPluginCaptureVirtual]("_capture", "message", "data", "session_id"),
+		NewEngineVirtualMethod[EditorDebuggerPluginGotoScriptLineVirtual]("_goto_script_line", "script", "line"),
+		NewEngineVirtualMethod[EditorDebuggerPluginBreakpointsClearedInTreeVirtual]("_breakpoints_cleared_in_tree"),
+		NewEngineVirtualMethod[EditorDebuggerPluginBreakpointSetInTreeVirtual]("_breakpoint_set_in_tree", "script", "line", "enabled"),
+	})
+	GDEngineVirtualMethods.Set("EditorExportPlatformExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorExportPlatformExtensionGetPresetFeaturesVirtual]("_get_preset_features", "preset"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionIsExecutableVirtual]("_is_executable", "path"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionGetExportOptionsVirtual]("_get_export_options"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionShouldUpdateExportOptionsVirtual]("_should_update_export_options"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionGetExportOptionVisibilityVirtual]("_get_export_option_visibility", "preset", "option"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionGetExportOptionWarningVirtual]("_get_export_option_warning", "preset", "option"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionGetOsNameVirtual]("_get_os_name"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionGetNameVirtual]("_get_name"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionGetLogoVirtual]("_get_logo"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionPollExportVirtual]("_poll_export"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionGetOptionsCountVirtual]("_get_options_count"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionGetOptionsTooltipVirtual]("_get_options_tooltip"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionGetOptionIconVirtual]("_get_option_icon", "device"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionGetOptionLabelVirtual]("_get_option_label", "device"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionGetOptionTooltipVirtual]("_get_option_tooltip", "device"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionGetDeviceArchitectureVirtual]("_get_device_architecture", "device"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionCleanupVirtual]("_cleanup"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionRunVirtual]("_run", "preset", "device", "debug_flags"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionGetRunIconVirtual]("_get_run_icon"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionCanExportVirtual]("_can_export", "preset", "debug"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionHasValidExportConfigurationVirtual]("_has_valid_export_configuration", "preset", "debug"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionHasValidProjectConfigurationVirtual]("_has_valid_project_configuration", "preset"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionGetBinaryExtensionsVirtual]("_get_binary_extensions", "preset"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionExportProjectVirtual]("_export_project", "preset", "debug", "path", "flags"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionExportPackVirtual]("_export_pack", "preset", "debug", "path", "flags"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionExportZipVirtual]("_export_zip", "preset", "debug", "path", "flags"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionExportPackPatchVirtual]("_export_pack_patch", "preset", "debug", "path", "patches", "flags"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionExportZipPatchVirtual]("_export_zip_patch", "preset", "debug", "path", "patches", "flags"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionGetPlatformFeaturesVirtual]("_get_platform_features"),
+		NewEngineVirtualMethod[EditorExportPlatformExtensionGetDebugProtocolVirtual]("_get_debug_protocol"),
+	})
+	GDEngineVirtualMethods.Set("EditorExportPlugin", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorExportPluginExportFileVirtual]("_export_file", "path", "type", "features"),
+		NewEngineVirtualMethod[EditorExportPluginExportBeginVirtual]("_export_begin", "features", "is_debug", "path", "flags"),
+		NewEngineVirtualMethod[EditorExportPluginExportEndVirtual]("_export_end"),
+		NewEngineVirtualMethod[EditorExportPluginBeginCustomizeResourcesVirtual]("_begin_customize_resources", "platform", "features"),
+		NewEngineVirtualMethod[EditorExportPluginCustomizeResourceVirtual]("_customize_resource", "resource", "path"),
+		NewEngineVirtualMethod[EditorExportPluginBeginCustomizeScenesVirtual]("_begin_customize_scenes", "platform", "features"),
+		NewEngineVirtualMethod[EditorExportPluginCustomizeSceneVirtual]("_customize_scene", "scene", "path"),
+		NewEngineVirtualMethod[EditorExportPluginGetCustomizationConfigurationHashVirtual]("_get_customization_configuration_hash"),
+		NewEngineVirtualMethod[EditorExportPluginEndCustomizeScenesVirtual]("_end_customize_scenes"),
+		NewEngineVirtualMethod[EditorExportPluginEndCustomizeResourcesVirtual]("_end_customize_resources"),
+		NewEngineVirtualMethod[EditorExportPluginGetExportOptionsVirtual]("_get_export_options", "platform"),
+		NewEngineVirtualMethod[EditorExportPluginGetExportOptionsOverridesVirtual]("_get_export_options_overrides", "platform"),
+		NewEngineVirtualMethod[EditorExportPluginShouldUpdateExportOptionsVirtual]("_should_update_export_options", "platform"),
+		NewEngineVirtualMethod[EditorExportPluginGetExportOptionVisibilityVirtual]("_get_export_option_visibility", "platform", "option"),
+		NewEngineVirtualMethod[EditorExportPluginGetExportOptionWarningVirtual]("_get_export_option_warning", "platform", "option"),
+		NewEngineVirtualMethod[EditorExportPluginGetExportFeaturesVirtual]("_get_export_features", "platform", "debug"),
+		NewEngineVirtualMethod[EditorExportPluginGetNameVirtual]("_get_name"),
+		NewEngineVirtualMethod[EditorExportPluginSupportsPlatformVirtual]("_supports_platform", "platform"),
+		NewEngineVirtualMethod[EditorExportPluginGetAndroidDependenciesVirtual]("_get_android_dependencies", "platform", "debug"),
+		NewEngineVirtualMethod[EditorExportPluginGetAndroidDependenciesMavenReposVirtual]("_get_android_dependencies_maven_repos", "platform", "debug"),
+		NewEngineVirtualMethod[EditorExportPluginGetAndroidLibrariesVirtual]("_get_android_libraries", "platform", "debug"),
+		NewEngineVirtualMethod[EditorExportPluginGetAndroidManifestActivityElementContentsVirtual]("_get_android_manifest_activity_element_contents", "platform", "debug"),
+		NewEngineVirtualMethod[EditorExportPluginGetAndroidManifestApplicationElementContentsVirtual]("_get_android_manifest_application_element_contents", "platform", "debug"),
+		NewEngineVirtualMethod[EditorExportPluginGetAndroidManifestElementContentsVirtual]("_get_android_manifest_element_contents", "platform", "debug"),
+		NewEngineVirtualMethod[EditorExportPluginUpdateAndroidPrebuiltManifestVirtual]("_update_android_prebuilt_manifest", "platform", "manifest_data"),
+	})
+	GDEngineVirtualMethods.Set("EditorFileSystemImportFormatSupportQuery", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorFileSystemImportFormatSupportQueryIsActiveVirtual]("_is_active"),
+		NewEngineVirtualMethod[EditorFileSystemImportFormatSupportQueryGetFileExtensionsVirtual]("_get_file_extensions"),
+		NewEngineVirtualMethod[EditorFileSystemImportFormatSupportQueryQueryVirtual]("_query"),
+	})
+	GDEngineVirtualMethods.Set("EditorImportPlugin", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorImportPluginGetImporterNameVirtual]("_get_importer_name"),
+		NewEngineVirtualMethod[EditorImportPluginGetVisibleNameVirtual]("_get_visible_name"),
+		NewEngineVirtualMethod[EditorImportPluginGetPresetCountVirtual]("_get_preset_count"),
+		NewEngineVirtualMethod[EditorImportPluginGetPresetNameVirtual]("_get_preset_name", "preset_index"),
+		NewEngineVirtualMethod[EditorImportPluginGetRecognizedExtensionsVirtual]("_get_recognized_extensions"),
+		NewEngineVirtualMethod[EditorImportPluginGetImportOptionsVirtual]("_get_import_options", "path", "preset_index"),
+		NewEngineVirtualMethod[EditorImportPluginGetSaveExtensionVirtual]("_get_save_extension"),
+		NewEngineVirtualMethod[EditorImportPluginGetResourceTypeVirtual]("_get_resource_type"),
+		NewEngineVirtualMethod[EditorImportPluginGetPriorityVirtual]("_get_priority"),
+		NewEngineVirtualMethod[EditorImportPluginGetImportOrderVirtual]("_get_import_order"),
+		NewEngineVirtualMethod[EditorImportPluginGetFormatVersionVirtual]("_get_format_version"),
+		NewEngineVirtualMethod[EditorImportPluginGetOptionVisibilityVirtual]("_get_option_visibility", "path", "option_name", "options"),
+		NewEngineVirtualMethod[EditorImportPluginImportVirtual]("_import", "source_file", "save_path", "options", "platform_variants", "gen_files"),
+		NewEngineVirtualMethod[EditorImportPluginCanImportThreadedVirtual]("_can_import_threaded"),
+	})
+	GDEngineVirtualMethods.Set("EditorInspectorPlugin", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorInspectorPluginCanHandleVirtual]("_can_handle", "object"),
+		NewEngineVirtualMethod[EditorInspectorPluginParseBeginVirtual]("_parse_begin", "object"),
+		NewEngineVirtualMethod[EditorInspectorPluginParseCategoryVirtual]("_parse_category", "object", "category"),
+		NewEngineVirtualMethod[EditorInspectorPluginParseGroupVirtual]("_parse_group", "object", "group"),
+		NewEngineVirtualMethod[EditorInspectorPluginParsePropertyVirtual]("_parse_property", "object", "type", "name", "hint_type", "hint_string", "usage_flags", "wide"),
+		NewEngineVirtualMethod[EditorInspectorPluginParseEndVirtual]("_parse_end", "object"),
+	})
+	GDEngineVirtualMethods.Set("EditorNode3DGizmo", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorNode3DGizmoRedrawVirtual]("_redraw"),
+		NewEngineVirtualMethod[EditorNode3DGizmoGetHandleNameVirtual]("_get_handle_name", "id", "secondary"),
+		NewEngineVirtualMethod[EditorNode3DGizmoIsHandleHighlightedVirtual]("_is_handle_highlighted", "id", "secondary"),
+		NewEngineVirtualMethod[EditorNode3DGizmoGetHandleValueVirtual]("_get_handle_value", "id", "secondary"),
+		NewEngineVirtualMethod[EditorNode3DGizmoBeginHandleActionVirtual]("_begin_handle_action", "id", "secondary"),
+		NewEngineVirtualMethod[EditorNode3DGizmoSetHandleVirtual]("_set_handle", "id", "secondary", "camera", "point"),
+		NewEngineVirtualMethod[EditorNode3DGizmoCommitHandleVirtual]("_commit_handle", "id", "secondary", "restore", "cancel"),
+		NewEngineVirtualMethod[EditorNode3DGizmoSubgizmosIntersectRayVirtual]("_subgizmos_intersect_ray", "camera", "point"),
+		NewEngineVirtualMethod[EditorNode3DGizmoSubgizmosIntersectFrustumVirtual]("_subgizmos_intersect_frustum", "camera", "frustum"),
+		NewEngineVirtualMethod[EditorNode3DGizmoSetSubgizmoTransformVirtual]("_set_subgizmo_transform", "id", "transform"),
+		NewEngineVirtualMethod[EditorNode3DGizmoGetSubgizmoTransformVirtual]("_get_subgizmo_transform", "id"),
+		NewEngineVirtualMethod[EditorNode3DGizmoCommitSubgizmosVirtual]("_commit_subgizmos", "ids", "restores", "cancel"),
+	})
+	GDEngineVirtualMethods.Set("EditorNode3DGizmoPlugin", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorNode3DGizmoPluginHasGizmoVirtual]("_has_gizmo", "for_node_3d"),
+		NewEngineVirtualMethod[EditorNode3DGizmoPluginCreateGizmoVirtual]("_create_gizmo", "for_node_3d"),
+		NewEngineVirtualMethod[EditorNode3DGizmoPluginGetGizmoNameVirtual]("_get_gizmo_name"),
+		NewEngineVirtualMethod[EditorNode3DGizmoPluginGetPriorityVirtual]("_get_priority"),
+		NewEngineVirtualMethod[EditorNode3DGizmoPluginCanBeHiddenVirtual]("_can_be_hidden"),
+		NewEngineVirtualMethod[EditorNode3DGizmoPluginIsSelectableWhenHiddenVirtual]("_is_selectable_when_hidden"),
+		NewEngineVirtualMethod[EditorNode3DGizmoPluginRedrawVirtual]("_redraw", "gizmo"),
+		NewEngineVirtualMethod[EditorNode3DGizmoPluginGetHandleNameVirtual]("_get_handle_name", "gizmo", "handle_id", "secondary"),
+		NewEngineVirtualMethod[EditorNode3DGizmoPluginIsHandleHighlightedVirtual]("_is_handle_highlighted", "gizmo", "handle_id", "secondary"),
+		NewEngineVirtualMethod[EditorNode3DGizmoPluginGetHandleValueVirtual]("_get_handle_value", "gizmo", "handle_id", "secondary"),
+		NewEngineVirtualMethod[EditorNode3DGizmoPluginBeginHandleActionVirtual]("_begin_handle_action", "gizmo", "handle_id", "secondary"),
+		NewEngineVirtualMethod[EditorNode3DGizmoPluginSetHandleVirtual]("_set_handle", "gizmo", "handle_id", "secondary", "camera", "screen_pos"),
+		NewEngineVirtualMethod[EditorNode3DGizmoPluginCommitHandleVirtual]("_commit_handle", "gizmo", "handle_id", "secondary", "restore", "cancel"),
+		NewEngineVirtualMethod[EditorNode3DGizmoPluginSubgizmosIntersectRayVirtual]("_subgizmos_intersect_ray", "gizmo", "camera", "screen_pos"),
+		NewEngineVirtualMethod[EditorNode3DGizmoPluginSubgizmosIntersectFrustumVirtual]("_subgizmos_intersect_frustum", "gizmo", "camera", "frustum_planes"),
+		NewEngineVirtualMethod[EditorNode3DGizmoPluginGetSubgizmoTransformVirtual]("_get_subgizmo_transform", "gizmo", "subgizmo_id"),
+		NewEngineVirtualMethod[EditorNode3DGizmoPluginSetSubgizmoTransformVirtual]("_set_subgizmo_transform", "gizmo", "subgizmo_id", "transform"),
+		NewEngineVirtualMethod[EditorNode3DGizmoPluginCommitSubgizmosVirtual]("_commit_subgizmos", "gizmo", "ids", "restores", "cancel"),
+	})
+	GDEngineVirtualMethods.Set("EditorPlugin", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorPluginForwardCanvasGuiInputVirtual]("_forward_canvas_gui_input", "event"),
+		NewEngineVirtualMethod[EditorPluginForwardCanvasDrawOverViewportVirtual]("_forward_canvas_draw_over_viewport", "viewport_control"),
+		NewEngineVirtualMethod[EditorPluginForwardCanvasForceDrawOverViewportVirtual]("_forward_canvas_force_draw_over_viewport", "viewport_control"),
+		NewEngineVirtualMethod[EditorPluginForward3DGuiInputVirtual]("_forward_3d_gui_input", "viewport_camera", "event"),
+		NewEngineVirtualMethod[EditorPluginForward3DDrawOverViewportVirtual]("_forward_3d_draw_over_viewport", "viewport_control"),
+		NewEngineVirtualMethod[EditorPluginForward3DForceDrawOverViewportVirtual]("_forward_3d_force_draw_over_viewport", "viewport_control"),
+		NewEngineVirtualMethod[EditorPluginGetPluginNameVirtual]("_get_plugin_name"),
+		NewEngineVirtualMethod[EditorPluginGetPluginIconVirtual]("_get_plugin_icon"),
+		NewEngineVirtualMethod[EditorPluginHasMainScreenVirtual]("_has_main_screen"),
+		NewEngineVirtualMethod[EditorPluginMakeVisibleVirtual]("_make_visible", "visible"),
+		NewEngineVirtualMethod[EditorPluginEditVirtual]("_edit", "object"),
+		NewEngineVirtualMethod[EditorPluginHandlesVirtual]("_handles", "object"),
+		NewEngineVirtualMethod[EditorPluginGetStateVirtual]("_get_state"),
+		NewEngineVirtualMethod[EditorPluginSetStateVirtual]("_set_state", "state"),
+		NewEngineVirtualMethod[EditorPluginClearVirtual]("_clear"),
+		NewEngineVirtualMethod[EditorPluginGetUnsavedStatusVirtual]("_get_unsaved_status", "for_scene"),
+		NewEngineVirtualMethod[EditorPluginSaveExternalDataVirtual]("_save_external_data"),
+		NewEngineVirtualMethod[EditorPluginApplyChangesVirtual]("_apply_changes"),
+		NewEngineVirtualMethod[EditorPluginGetBreakpointsVirtual]("_get_breakpoints"),
+		NewEngineVirtualMethod[EditorPluginSetWindowLayoutVirtual]("_set_window_layout", "configuration"),
+		NewEngineVirtualMethod[EditorPluginGetWindowLayoutVirtual]("_get_window_layout", "configuration"),
+		NewEngineVirtualMethod[EditorPluginBuildVirtual]("_build"),
+		NewEngineVirtualMethod[EditorPluginEnablePluginVirtual]("_enable_plugin"),
+		NewEngineVirtualMethod[EditorPluginDisablePluginVirtual]("_disable_plugin"),
+	})
+	GDEngineVirtualMethods.Set("EditorProperty", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorPropertyUpdatePropertyVirtual]("_update_property"),
+		NewEngineVirtualMethod[EditorPropertySetReadOnlyVirtual]("_set_read_only", "read_only"),
+	})
+	GDEngineVirtualMethods.Set("EditorResourceConversionPlugin", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorResourceConversionPluginConvertsToVirtual]("_converts_to"),
+		NewEngineVirtualMethod[EditorResourceConversionPluginHandlesVirtual]("_handles", "resource"),
+		NewEngineVirtualMethod[EditorResourceConversionPluginConvertVirtual]("_convert", "resource"),
+	})
+	GDEngineVirtualMethods.Set("EditorResourcePicker", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorResourcePickerSetCreateOptionsVirtual]("_set_create_options", "menu_node"),
+		NewEngineVirtualMethod[EditorResourcePickerHandleMenuSelectedVirtual]("_handle_menu_selected", "id"),
+	})
+	GDEngineVirtualMethods.Set("EditorResourcePreviewGenerator", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorResourcePreviewGeneratorHandlesVirtual]("_handles", "type"),
+		NewEngineVirtualMethod[EditorResourcePreviewGeneratorGenerateVirtual]("_generate", "resource", "size", "metadata"),
+		NewEngineVirtualMethod[EditorResourcePreviewGeneratorGenerateFromPathVirtual]("_generate_from_path", "path", "size", "metadata"),
+		NewEngineVirtualMethod[EditorResourcePreviewGeneratorGenerateSmallPreviewAutomaticallyVirtual]("_generate_small_preview_automatically"),
+		NewEngineVirtualMethod[EditorResourcePreviewGeneratorCanGenerateSmallPreviewVirtual]("_can_generate_small_preview"),
+	})
+	GDEngineVirtualMethods.Set("EditorResourceTooltipPlugin", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorResourceTooltipPluginHandlesVirtual]("_handles", "type"),
+		NewEngineVirtualMethod[EditorResourceTooltipPluginMakeTooltipForPathVirtual]("_make_tooltip_for_path", "path", "metadata", "base"),
+	})
+	GDEngineVirtualMethods.Set("EditorSceneFormatImporter", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorSceneFormatImporterGetExtensionsVirtual]("_get_extensions"),
+		NewEngineVirtualMethod[EditorSceneFormatImporterImportSceneVirtual]("_import_scene", "path", "flags", "options"),
+		NewEngineVirtualMethod[EditorSceneFormatImporterGetImportOptionsVirtual]("_get_import_options", "path"),
+		NewEngineVirtualMethod[EditorSceneFormatImporterGetOptionVisibilityVirtual]("_get_option_visibility", "path", "for_animation", "option"),
+	})
+	GDEngineVirtualMethods.Set("EditorScenePostImport", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorScenePostImportPostImportVirtual]("_post_import", "scene"),
+	})
+	GDEngineVirtualMethods.Set("EditorScenePostImportPlugin", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorScenePostImportPluginGetInternalImportOptionsVirtual]("_get_internal_import_options", "category"),
+		NewEngineVirtualMethod[EditorScenePostImportPluginGetInternalOptionVisibilityVirtual]("_get_internal_option_visibility", "category", "for_animation", "option"),
+		NewEngineVirtualMethod[EditorScenePostImportPluginGetInternalOptionUpdateViewRequiredVirtual]("_get_internal_option_update_view_required", "category", "option"),
+		NewEngineVirtualMethod[EditorScenePostImportPluginInternalProcessVirtual]("_internal_process", "category", "base_node", "node", "resource"),
+		NewEngineVirtualMethod[EditorScenePostImportPluginGetImportOptionsVirtual]("_get_import_options", "path"),
+		NewEngineVirtualMethod[EditorScenePostImportPluginGetOptionVisibilityVirtual]("_get_option_visibility", "path", "for_animation", "option"),
+		NewEngineVirtualMethod[EditorScenePostImportPluginPreProcessVirtual]("_pre_process", "scene"),
+		NewEngineVirtualMethod[EditorScenePostImportPluginPostProcessVirtual]("_post_process", "scene"),
+	})
+	GDEngineVirtualMethods.Set("EditorScript", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorScriptRunVirtual]("_run"),
+	})
+	GDEngineVirtualMethods.Set("EditorSyntaxHighlighter", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorSyntaxHighlighterGetNameVirtual]("_get_name"),
+		NewEngineVirtualMethod[EditorSyntaxHighlighterGetSupportedLanguagesVirtual]("_get_supported_languages"),
+		NewEngineVirtualMethod[EditorSyntaxHighlighterCreateVirtual]("_create"),
+	})
+	GDEngineVirtualMethods.Set("EditorTranslationParserPlugin", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorTranslationParserPluginParseFileVirtual]("_parse_file", "path"),
+		NewEngineVirtualMethod[EditorTranslationParserPluginGetRecognizedExtensionsVirtual]("_get_recognized_extensions"),
+	})
+	GDEngineVirtualMethods.Set("EditorVCSInterface", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EditorVCSInterfaceInitializeVirtual]("_initialize", "project_path"),
+		NewEngineVirtualMethod[EditorVCSInterfaceSetCredentialsVirtual]("_set_credentials", "username", "password", "ssh_public_key_path", "ssh_private_key_path", "ssh_passphrase"),
+		NewEngineVirtualMethod[EditorVCSInterfaceGetModifiedFilesDataVirtual]("_get_modified_files_data"),
+		NewEngineVirtualMethod[EditorVCSInterfaceStageFileVirtual]("_stage_file", "file_path"),
+		NewEngineVirtualMethod[EditorVCSInterfaceUnstageFileVirtual]("_unstage_file", "file_path"),
+		NewEngineVirtualMethod[EditorVCSInterfaceDiscardFileVirtual]("_discard_file", "file_path"),
+		NewEngineVirtualMethod[EditorVCSInterfaceCommitVirtual]("_commit", "msg"),
+		NewEngineVirtualMethod[EditorVCSInterfaceGetDiffVirtual]("_get_diff", "identifier", "area"),
+		NewEngineVirtualMethod[EditorVCSInterfaceShutDownVirtual]("_shut_down"),
+		NewEngineVirtualMethod[EditorVCSInterfaceGetVcsNameVirtual]("_get_vcs_name"),
+		NewEngineVirtualMethod[EditorVCSInterfaceGetPreviousCommitsVirtual]("_get_previous_commits", "max_commits"),
+		NewEngineVirtualMethod[EditorVCSInterfaceGetBranchListVirtual]("_get_branch_list"),
+		NewEngineVirtualMethod[EditorVCSInterfaceGetRemotesVirtual]("_get_remotes"),
+		NewEngineVirtualMethod[EditorVCSInterfaceCreateBranchVirtual]("_create_branch", "branch_name"),
+		NewEngineVirtualMethod[EditorVCSInterfaceRemoveBranchVirtual]("_remove_branch", "branch_name"),
+		NewEngineVirtualMethod[EditorVCSInterfaceCreateRemoteVirtual]("_create_remote", "remote_name", "remote_url"),
+		NewEngineVirtualMethod[EditorVCSInterfaceRemoveRemoteVirtual]("_remove_remote", "remote_name"),
+		NewEngineVirtualMethod[EditorVCSInterfaceGetCurrentBranchNameVirtual]("_get_current_branch_name"),
+		NewEngineVirtualMethod[EditorVCSInterfaceCheckoutBranchVirtual]("_checkout_branch", "branch_name"),
+		NewEngineVirtualMethod[EditorVCSInterfacePullVirtual]("_pull", "remote"),
+		NewEngineVirtualMethod[EditorVCSInterfacePushVirtual]("_push", "remote", "force"),
+		NewEngineVirtualMethod[EditorVCSInterfaceFetchVirtual]("_fetch", "remote"),
+		NewEngineVirtualMethod[EditorVCSInterfaceGetLineDiffVirtual]("_get_line_diff", "file_path", "text"),
+	})
+	GDEngineVirtualMethods.Set("EngineProfiler", []EngineVirtualMethod{
+		NewEngineVirtualMethod[EngineProfilerToggleVirtual]("_toggle", "enable", "options"),
+		NewEngineVirtualMethod[EngineProfilerAddFrameVirtual]("_add_frame", "data"),
+		NewEngineVirtualMethod[EngineProfilerTickVirtual]("_tick", "frame_time", "process_time", "physics_time", "physics_frame_time"),
+	})
+	GDEngineVirtualMethods.Set("GLTFDocumentExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[GLTFDocumentExtensionImportPreflightVirtual]("_import_preflight", "state", "extensions"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionGetSupportedExtensionsVirtual]("_get_supported_extensions"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionParseNodeExtensionsVirtual]("_parse_node_extensions", "state", "gltf_node", "extensions"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionParseImageDataVirtual]("_parse_image_data", "state", "image_data", "mime_type", "ret_image"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionGetImageFileExtensionVirtual]("_get_image_file_extension"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionParseTextureJsonVirtual]("_parse_texture_json", "state", "texture_json", "ret_gltf_texture"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionImportObjectModelPropertyVirtual]("_import_object_model_property", "state", "split_json_pointer", "partial_paths"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionImportPostParseVirtual]("_import_post_parse", "state"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionImportPreGenerateVirtual]("_import_pre_generate", "state"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionGenerateSceneNodeVirtual]("_generate_scene_node", "state", "gltf_node", "scene_parent"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionImportNodeVirtual]("_import_node", "state", "gltf_node", "json", "node"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionImportPostVirtual]("_import_post", "state", "root"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionExportPreflightVirtual]("_export_preflight", "state", "root"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionConvertSceneNodeVirtual]("_convert_scene_node", "state", "gltf_node", "scene_node"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionExportPostConvertVirtual]("_export_post_convert", "state", "root"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionExportPreserializeVirtual]("_export_preserialize", "state"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionExportObjectModelPropertyVirtual]("_export_object_model_property", "state", "node_path", "godot_node", "gltf_node_index", "target_object", "target_depth"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionGetSaveableImageFormatsVirtual]("_get_saveable_image_formats"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionSerializeImageToBytesVirtual]("_serialize_image_to_bytes", "state", "image", "image_dict", "image_format", "lossy_quality"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionSaveImageAtPathVirtual]("_save_image_at_path", "state", "image", "file_path", "image_format", "lossy_quality"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionSerializeTextureJsonVirtual]("_serialize_texture_json", "state", "texture_json", "gltf_texture", "image_format"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionExportNodeVirtual]("_export_node", "state", "gltf_node", "json", "node"),
+		NewEngineVirtualMethod[GLTFDocumentExtensionExportPostVirtual]("_export_post", "state"),
+	})
+	GDEngineVirtualMethods.Set("GraphEdit", []EngineVirtualMethod{
+		NewEngineVirtualMethod[GraphEditIsInInputHotzoneVirtual]("_is_in_input_hotzone", "in_node", "in_port", "mouse_position"),
+		NewEngineVirtualMethod[GraphEditIsInOutputHotzoneVirtual]("_is_in_output_hotzone", "in_node", "in_port", "mouse_position"),
+		NewEngineVirtualMethod[GraphEditGetConnectionLineVirtual]("_get_connection_line", "from_position", "to_position"),
+		NewEngineVirtualMethod[GraphEditIsNodeHoverValidVirtual]("_is_node_hover_valid", "from_node", "from_port", "to_node", "to_port"),
+	})
+	GDEngineVirtualMethods.Set("GraphNode", []EngineVirtualMethod{
+		NewEngineVirtualMethod[GraphNodeDrawPortVirtual]("_draw_port", "slot_index", "position", "left", "color"),
+	})
+	GDEngineVirtualMethods.Set("ImageFormatLoaderExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[ImageFormatLoaderExtensionGetRecognizedExtensionsVirtual]("_get_recognized_extensions"),
+		NewEngineVirtualMethod[ImageFormatLoaderExtensionLoadImageVirtual]("_load_image", "image", "fileaccess", "flags", "scale"),
+	})
+	GDEngineVirtualMethods.Set("Logger", []EngineVirtualMethod{
+		NewEngineVirtualMethod[LoggerLogErrorVirtual]("_log_error", "function", "file", "line", "code", "rationale", "editor_notify", "error_type", "script_backtraces"),
+		NewEngineVirtualMethod[LoggerLogMessageVirtual]("_log_message", "message", "error"),
+	})
+	GDEngineVirtualMethods.Set("MainLoop", []EngineVirtualMethod{
+		NewEngineVirtualMethod[MainLoopInitializeVirtual]("_initialize"),
+		NewEngineVirtualMethod[MainLoopPhysicsProcessVirtual]("_physics_process", "delta"),
+		NewEngineVirtualMethod[MainLoopProcessVirtual]("_process", "delta"),
+		NewEngineVirtualMethod[MainLoopFinalizeVirtual]("_finalize"),
+	})
+	GDEngineVirtualMethods.Set("Material", []EngineVirtualMethod{
+		NewEngineVirtualMethod[MaterialGetShaderRidVirtual]("_get_shader_rid"),
+		NewEngineVirtualMethod[MaterialGetShaderModeVirtual]("_get_shader_mode"),
+		NewEngineVirtualMethod[MaterialCanDoNextPassVirtual]("_can_do_next_pass"),
+		NewEngineVirtualMethod[MaterialCanUseRenderPriorityVirtual]("_can_use_render_priority"),
+	})
+	GDEngineVirtualMethods.Set("Mesh", []EngineVirtualMethod{
+		NewEngineVirtualMethod[MeshGetSurfaceCountVirtual]("_get_surface_count"),
+		NewEngineVirtualMethod[MeshSurfaceGetArrayLenVirtual]("_surface_get_array_len", "index"),
+		NewEngineVirtualMethod[MeshSurfaceGetArrayIndexLenVirtual]("_surface_get_array_index_len", "index"),
+		NewEngineVirtualMethod[MeshSurfaceGetArraysVirtual]("_surface_get_arrays", "index"),
+		NewEngineVirtualMethod[MeshSurfaceGetBlendShapeArraysVirtual]("_surface_get_blend_shape_arrays", "index"),
+		NewEngineVirtualMethod[MeshSurfaceGetLodsVirtual]("_surface_get_lods", "index"),
+		NewEngineVirtualMethod[MeshSurfaceGetFormatVirtual]("_surface_get_format", "index"),
+		NewEngineVirtualMethod[MeshSurfaceGetPrimitiveTypeVirtual]("_surface_get_primitive_type", "index"),
+		NewEngineVirtualMethod[MeshSurfaceSetMaterialVirtual]("_surface_set_material", "index", "material"),
+		NewEngineVirtualMethod[MeshSurfaceGetMaterialVirtual]("_surface_get_material", "index"),
+		NewEngineVirtualMethod[MeshGetBlendShapeCountVirtual]("_get_blend_shape_count"),
+		NewEngineVirtualMethod[MeshGetBlendShapeNameVirtual]("_get_blend_shape_name", "index"),
+		NewEngineVirtualMethod[MeshSetBlendShapeNameVirtual]("_set_blend_shape_name", "index", "name"),
+		NewEngineVirtualMethod[MeshGetAabbVirtual]("_get_aabb"),
+	})
+	GDEngineVirtualMethods.Set("MovieWriter", []EngineVirtualMethod{
+		NewEngineVirtualMethod[MovieWriterGetAudioMixRateVirtual]("_get_audio_mix_rate"),
+		NewEngineVirtualMethod[MovieWriterGetAudioSpeakerModeVirtual]("_get_audio_speaker_mode"),
+		NewEngineVirtualMethod[MovieWriterHandlesFileVirtual]("_handles_file", "path"),
+		NewEngineVirtualMethod[MovieWriterWriteBeginVirtual]("_write_begin", "movie_size", "fps", "base_path"),
+		NewEngineVirtualMethod[MovieWriterWriteFrameVirtual]("_write_frame", "frame_image", "audio_frame_block"),
+		NewEngineVirtualMethod[MovieWriterWriteEndVirtual]("_write_end"),
+	})
+	GDEngineVirtualMethods.Set("MultiplayerAPIExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[MultiplayerAPIExtensionPollVirtual]("_poll"),
+		NewEngineVirtualMethod[MultiplayerAPIExtensionSetMultiplayerPeerVirtual]("_set_multiplayer_peer", "multiplayer_peer"),
+		NewEngineVirtualMethod[MultiplayerAPIExtensionGetMultiplayerPeerVirtual]("_get_multiplayer_peer"),
+		NewEngineVirtualMethod[MultiplayerAPIExtensionGetUniqueIdVirtual]("_get_unique_id"),
+		NewEngineVirtualMethod[MultiplayerAPIExtensionGetPeerIdsVirtual]("_get_peer_ids"),
+		NewEngineVirtualMethod[MultiplayerAPIExtensionRpcVirtual]("_rpc", "peer", "object", "method", "args"),
+		NewEngineVirtualMethod[MultiplayerAPIExtensionGetRemoteSenderIdVirtual]("_get_remote_sender_id"),
+		NewEngineVirtualMethod[MultiplayerAPIExtensionObjectConfigurationAddVirtual]("_object_configuration_add", "object", "configuration"),
+		NewEngineVirtualMethod[MultiplayerAPIExtensionObjectConfigurationRemoveVirtual]("_object_configuration_remove", "object", "configuration"),
+	})
+	GDEngineVirtualMethods.Set("MultiplayerPeerExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[MultiplayerPeerExtensionGetPacketVirtual]("_get_packet", "r_buffer", "r_buffer_size"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionPutPacketVirtual]("_put_packet", "p_buffer", "p_buffer_size"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionGetAvailablePacketCountVirtual]("_get_available_packet_count"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionGetMaxPacketSizeVirtual]("_get_max_packet_size"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionGetPacketScriptVirtual]("_get_packet_script"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionPutPacketScriptVirtual]("_put_packet_script", "p_buffer"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionGetPacketChannelVirtual]("_get_packet_channel"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionGetPacketModeVirtual]("_get_packet_mode"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionSetTransferChannelVirtual]("_set_transfer_channel", "p_channel"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionGetTransferChannelVirtual]("_get_transfer_channel"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionSetTransferModeVirtual]("_set_transfer_mode", "p_mode"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionGetTransferModeVirtual]("_get_transfer_mode"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionSetTargetPeerVirtual]("_set_target_peer", "p_peer"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionGetPacketPeerVirtual]("_get_packet_peer"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionIsServerVirtual]("_is_server"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionPollVirtual]("_poll"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionCloseVirtual]("_close"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionDisconnectPeerVirtual]("_disconnect_peer", "p_peer", "p_force"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionGetUniqueIdVirtual]("_get_unique_id"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionSetRefuseNewConnectionsVirtual]("_set_refuse_new_connections", "p_enable"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionIsRefusingNewConnectionsVirtual]("_is_refusing_new_connections"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionIsServerRelaySupportedVirtual]("_is_server_relay_supported"),
+		NewEngineVirtualMethod[MultiplayerPeerExtensionGetConnectionStatusVirtual]("_get_connection_status"),
+	})
+	GDEngineVirtualMethods.Set("Node", []EngineVirtualMethod{
+		NewEngineVirtualMethod[NodeProcessVirtual]("_process", "delta"),
+		NewEngineVirtualMethod[NodePhysicsProcessVirtual]("_physics_process", "delta"),
+		NewEngineVirtualMethod[NodeEnterTreeVirtual]("_enter_tree"),
+		NewEngineVirtualMethod[NodeExitTreeVirtual]("_exit_tree"),
+		NewEngineVirtualMethod[NodeReadyVirtual]("_ready"),
+		NewEngineVirtualMethod[NodeGetConfigurationWarningsVirtual]("_get_configuration_warnings"),
+		NewEngineVirtualMethod[NodeGetAccessibilityConfigurationWarningsVirtual]("_get_accessibility_configuration_warnings"),
+		NewEngineVirtualMethod[NodeInputVirtual]("_input", "event"),
+		NewEngineVirtualMethod[NodeShortcutInputVirtual]("_shortcut_input", "event"),
+		NewEngineVirtualMethod[NodeUnhandledInputVirtual]("_unhandled_input", "event"),
+		NewEngineVirtualMethod[NodeUnhandledKeyInputVirtual]("_unhandled_key_input", "event"),
+		NewEngineVirtualMethod[NodeGetFocusedAccessibilityElementVirtual]("_get_focused_accessibility_element"),
+	})
+	GDEngineVirtualMethods.Set("OpenXRBindingModifier", []EngineVirtualMethod{
+		NewEngineVirtualMethod[OpenXRBindingModifierGetDescriptionVirtual]("_get_description"),
+		NewEngineVirtualMethod[OpenXRBindingModifierGetIpModificationVirtual]("_get_ip_modification"),
+	})
+	GDEngineVirtualMethods.Set("OpenXRExtensionWrapper", []EngineVirtualMethod{
+		NewEngineVirtualMethod[OpenXRExtensionWrapperGetRequestedExtensionsVirtual]("_get_requested_extensions"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperSetSystemPropertiesAndGetNextPointerVirtual]("_set_system_properties_and_get_next_pointer", "next_pointer"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperSetInstanceCreateInfoAndGetNextPointerVirtual]("_set_instance_create_info_and_get_next_pointer", "next_pointer"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperSetSessionCreateAndGetNextPointerVirtual]("_set_session_create_and_get_next_pointer", "next_pointer"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperSetSwapchainCreateInfoAndGetNextPointerVirtual]("_set_swapchain_create_info_and_get_next_pointer", "next_pointer"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperSetHandJointLocationsAndGetNextPointerVirtual]("_set_hand_joint_locations_and_get_next_pointer", "hand_index", "next_pointer"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperSetProjectionViewsAndGetNextPointerVirtual]("_set_projection_views_and_get_next_pointer", "view_index", "next_pointer"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperSetFrameWaitInfoAndGetNextPointerVirtual]("_set_frame_wait_info_and_get_next_pointer", "next_pointer"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperSetFrameEndInfoAndGetNextPointerVirtual]("_set_frame_end_info_and_get_next_pointer", "next_pointer"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperSetViewLocateInfoAndGetNextPointerVirtual]("_set_view_locate_info_and_get_next_pointer", "next_pointer"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperSetReferenceSpaceCreateInfoAndGetNextPointerVirtual]("_set_reference_space_create_info_and_get_next_pointer", "reference_space_type", "next_pointer"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperGetCompositionLayerCountVirtual]("_get_composition_layer_count"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperGetCompositionLayerVirtual]("_get_composition_layer", "index"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperGetCompositionLayerOrderVirtual]("_get_composition_layer_order", "index"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperGetSuggestedTrackerNamesVirtual]("_get_suggested_tracker_names"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnRegisterMetadataVirtual]("_on_register_metadata"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnBeforeInstanceCreatedVirtual]("_on_before_instance_created"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnInstanceCreatedVirtual]("_on_instance_created", "instance"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnInstanceDestroyedVirtual]("_on_instance_destroyed"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnSessionCreatedVirtual]("_on_session_created", "session"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnProcessVirtual]("_on_process"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnSyncActionsVirtual]("_on_sync_actions"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnPreRenderVirtual]("_on_pre_render"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnMainSwapchainsCreatedVirtual]("_on_main_swapchains_created"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnPreDrawViewportVirtual]("_on_pre_draw_viewport", "viewport"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnPostDrawViewportVirtual]("_on_post_draw_viewport", "viewport"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnSessionDestroyedVirtual]("_on_session_destroyed"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnStateIdleVirtual]("_on_state_idle"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnStateReadyVirtual]("_on_state_ready"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnStateSynchronizedVirtual]("_on_state_synchronized"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnStateVisibleVirtual]("_on_state_visible"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnStateFocusedVirtual]("_on_state_focused"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnStateStoppingVirtual]("_on_state_stopping"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnStateLossPendingVirtual]("_on_state_loss_pending"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnStateExitingVirtual]("_on_state_exiting"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnEventPolledVirtual]("_on_event_polled", "event"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperSetViewportCompositionLayerAndGetNextPointerVirtual]("_set_viewport_composition_layer_and_get_next_pointer", "layer", "property_values", "next_pointer"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperGetViewportCompositionLayerExtensionPropertiesVirtual]("_get_viewport_composition_layer_extension_properties"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperGetViewportCompositionLayerExtensionPropertyDefaultsVirtual]("_get_viewport_composition_layer_extension_property_defaults"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperOnViewportCompositionLayerDestroyedVirtual]("_on_viewport_composition_layer_destroyed", "layer"),
+		NewEngineVirtualMethod[OpenXRExtensionWrapperSetAndroidSurfaceSwapchainCreateInfoAndGetNextPointerVirtual]("_set_android_surface_swapchain_create_info_and_get_next_pointer", "property_values", "next_pointer"),
+	})
+	GDEngineVirtualMethods.Set("PacketPeerExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[PacketPeerExtensionGetPacketVirtual]("_get_packet", "r_buffer", "r_buffer_size"),
+		NewEngineVirtualMethod[PacketPeerExtensionPutPacketVirtual]("_put_packet", "p_buffer", "p_buffer_size"),
+		NewEngineVirtualMethod[PacketPeerExtensionGetAvailablePacketCountVirtual]("_get_available_packet_count"),
+		NewEngineVirtualMethod[PacketPeerExtensionGetMaxPacketSizeVirtual]("_get_max_packet_size"),
+	})
+	GDEngineVirtualMethods.Set("PhysicalBone3D", []EngineVirtualMethod{
+		NewEngineVirtualMethod[PhysicalBone3DIntegrateForcesVirtual]("_integrate_forces", "state"),
+	})
+	GDEngineVirtualMethods.Set("PhysicsDirectBodyState2DExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetTotalGravityVirtual]("_get_total_gravity"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetTotalLinearDampVirtual]("_get_total_linear_damp"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetTotalAngularDampVirtual]("_get_total_angular_damp"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetCenterOfMassVirtual]("_get_center_of_mass"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetCenterOfMassLocalVirtual]("_get_center_of_mass_local"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetInverseMassVirtual]("_get_inverse_mass"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetInverseInertiaVirtual]("_get_inverse_inertia"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionSetLinearVelocityVirtual]("_set_linear_velocity", "velocity"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetLinearVelocityVirtual]("_get_linear_velocity"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionSetAngularVelocityVirtual]("_set_angular_velocity", "velocity"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetAngularVelocityVirtual]("_get_angular_velocity"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionSetTransformVirtual]("_set_transform", "transform"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetTransformVirtual]("_get_transform"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetVelocityAtLocalPositionVirtual]("_get_velocity_at_local_position", "local_position"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionApplyCentralImpulseVirtual]("_apply_central_impulse", "impulse"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionApplyImpulseVirtual]("_apply_impulse", "impulse", "position"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionApplyTorqueImpulseVirtual]("_apply_torque_impulse", "impulse"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionApplyCentralForceVirtual]("_apply_central_force", "force"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionApplyForceVirtual]("_apply_force", "force", "position"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionApplyTorqueVirtual]("_apply_torque", "torque"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionAddConstantCentralForceVirtual]("_add_constant_central_force", "force"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionAddConstantForceVirtual]("_add_constant_force", "force", "position"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionAddConstantTorqueVirtual]("_add_constant_torque", "torque"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionSetConstantForceVirtual]("_set_constant_force", "force"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetConstantForceVirtual]("_get_constant_force"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionSetConstantTorqueVirtual]("_set_constant_torque", "torque"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetConstantTorqueVirtual]("_get_constant_torque"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionSetSleepStateVirtual]("_set_sleep_state", "enabled"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionIsSleepingVirtual]("_is_sleeping"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionSetCollisionLayerVirtual]("_set_collision_layer", "layer"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetCollisionLayerVirtual]("_get_collision_layer"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionSetCollisionMaskVirtual]("_set_collision_mask", "mask"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetCollisionMaskVirtual]("_get_collision_mask"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetContactCountVirtual]("_get_contact_count"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetContactLocalPositionVirtual]("_get_contact_local_position", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetContactLocalNormalVirtual]("_get_contact_local_normal", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetContactLocalShapeVirtual]("_get_contact_local_shape", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetContactLocalVelocityAtPositionVirtual]("_get_contact_local_velocity_at_position", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetContactColliderVirtual]("_get_contact_collider", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetContactColliderPositionVirtual]("_get_contact_collider_position", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetContactColliderIdVirtual]("_get_contact_collider_id", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetContactColliderObjectVirtual]("_get_contact_collider_object", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetContactColliderShapeVirtual]("_get_contact_collider_shape", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetContactColliderVelocityAtPositionVirtual]("_get_contact_collider_velocity_at_position", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetContactImpulseVirtual]("_get_contact_impulse", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetStepVirtual]("_get_step"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionIntegrateForcesVirtual]("_integrate_forces"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState2DExtensionGetSpaceStateVirtual]("_get_space_state"),
+	})
+	GDEngineVirtualMethods.Set("PhysicsDirectBodyState3DExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetTotalGravityVirtual]("_get_total_gravity"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetTotalLinearDampVirtual]("_get_total_linear_damp"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetTotalAngularDampVirtual]("_get_total_angular_damp"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetCenterOfMassVirtual]("_get_center_of_mass"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetCenterOfMassLocalVirtual]("_get_center_of_mass_local"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetPrincipalInertiaAxesVirtual]("_get_principal_inertia_axes"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetInverseMassVirtual]("_get_inverse_mass"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetInverseInertiaVirtual]("_get_inverse_inertia"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetInverseInertiaTensorVirtual]("_get_inverse_inertia_tensor"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionSetLinearVelocityVirtual]("_set_linear_velocity", "velocity"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetLinearVelocityVirtual]("_get_linear_velocity"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionSetAngularVelocityVirtual]("_set_angular_velocity", "velocity"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetAngularVelocityVirtual]("_get_angular_velocity"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionSetTransformVirtual]("_set_transform", "transform"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetTransformVirtual]("_get_transform"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetVelocityAtLocalPositionVirtual]("_get_velocity_at_local_position", "local_position"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionApplyCentralImpulseVirtual]("_apply_central_impulse", "impulse"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionApplyImpulseVirtual]("_apply_impulse", "impulse", "position"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionApplyTorqueImpulseVirtual]("_apply_torque_impulse", "impulse"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionApplyCentralForceVirtual]("_apply_central_force", "force"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionApplyForceVirtual]("_apply_force", "force", "position"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionApplyTorqueVirtual]("_apply_torque", "torque"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionAddConstantCentralForceVirtual]("_add_constant_central_force", "force"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionAddConstantForceVirtual]("_add_constant_force", "force", "position"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionAddConstantTorqueVirtual]("_add_constant_torque", "torque"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionSetConstantForceVirtual]("_set_constant_force", "force"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetConstantForceVirtual]("_get_constant_force"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionSetConstantTorqueVirtual]("_set_constant_torque", "torque"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetConstantTorqueVirtual]("_get_constant_torque"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionSetSleepStateVirtual]("_set_sleep_state", "enabled"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionIsSleepingVirtual]("_is_sleeping"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionSetCollisionLayerVirtual]("_set_collision_layer", "layer"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetCollisionLayerVirtual]("_get_collision_layer"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionSetCollisionMaskVirtual]("_set_collision_mask", "mask"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetCollisionMaskVirtual]("_get_collision_mask"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetContactCountVirtual]("_get_contact_count"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetContactLocalPositionVirtual]("_get_contact_local_position", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetContactLocalNormalVirtual]("_get_contact_local_normal", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetContactImpulseVirtual]("_get_contact_impulse", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetContactLocalShapeVirtual]("_get_contact_local_shape", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetContactLocalVelocityAtPositionVirtual]("_get_contact_local_velocity_at_position", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetContactColliderVirtual]("_get_contact_collider", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetContactColliderPositionVirtual]("_get_contact_collider_position", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetContactColliderIdVirtual]("_get_contact_collider_id", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetContactColliderObjectVirtual]("_get_contact_collider_object", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetContactColliderShapeVirtual]("_get_contact_collider_shape", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetContactColliderVelocityAtPositionVirtual]("_get_contact_collider_velocity_at_position", "contact_idx"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetStepVirtual]("_get_step"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionIntegrateForcesVirtual]("_integrate_forces"),
+		NewEngineVirtualMethod[PhysicsDirectBodyState3DExtensionGetSpaceStateVirtual]("_get_space_state"),
+	})
+	GDEngineVirtualMethods.Set("PhysicsDirectSpaceState2DExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[PhysicsDirectSpaceState2DExtensionIntersectRayVirtual]("_intersect_ray", "from", "to", "collision_mask", "collide_with_bodies", "collide_with_areas", "hit_from_inside", "result"),
+		NewEngineVirtualMethod[PhysicsDirectSpaceState2DExtensionIntersectPointVirtual]("_intersect_point", "position", "canvas_instance_id", "collision_mask", "collide_with_bodies", "collide_with_areas", "results", "max_results"),
+		NewEngineVirtualMethod[PhysicsDirectSpaceState2DExtensionIntersectShapeVirtual]("_intersect_shape", "shape_rid", "transform", "motion", "margin", "collision_mask", "collide_with_bodies", "collide_with_areas", "result", "max_results"),
+		NewEngineVirtualMethod[PhysicsDirectSpaceState2DExtensionCastMotionVirtual]("_cast_motion", "shape_rid", "transform", "motion", "margin", "collision_mask", "collide_with_bodies", "collide_with_areas", "closest_safe", "closest_unsafe"),
+		NewEngineVirtualMethod[PhysicsDirectSpaceState2DExtensionCollideShapeVirtual]("_collide_shape", "shape_rid", "transform", "motion", "margin", "collision_mask", "collide_with_bodies", "collide_with_areas", "results", "max_results", "result_count"),
+		NewEngineVirtualMethod[PhysicsDirectSpaceState2DExtensionRestInfoVirtual]("_rest_info", "shape_rid", "transform", "motion", "margin", "collision_mask", "collide_with_bodies", "collide_with_areas", "rest_info"),
+	})
+	GDEngineVirtualMethods.Set("PhysicsDirectSpaceState3DExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[PhysicsDirectSpaceState3DExtensionIntersectRayVirtual]("_intersect_ray", "from", "to", "collision_mask", "collide_with_bodies", "collide_with_areas", "hit_from_inside", "hit_back_faces", "pick_ray", "result"),
+		NewEngineVirtualMethod[PhysicsDirectSpaceState3DExtensionIntersectPointVirtual]("_intersect_point", "position", "collision_mask", "collide_with_bodies", "collide_with_areas", "results", "max_results"),
+		NewEngineVirtualMethod[PhysicsDirectSpaceState3DExtensionIntersectShapeVirtual]("_intersect_shape", "shape_rid", "transform", "motion", "margin", "collision_mask", "collide_with_bodies", "collide_with_areas", "result_count", "max_results"),
+		NewEngineVirtualMethod[PhysicsDirectSpaceState3DExtensionCastMotionVirtual]("_cast_motion", "shape_rid", "transform", "motion", "margin", "collision_mask", "collide_with_bodies", "collide_with_areas", "closest_safe", "closest_unsafe", "info"),
+		NewEngineVirtualMethod[PhysicsDirectSpaceState3DExtensionCollideShapeVirtual]("_collide_shape", "shape_rid", "transform", "motion", "margin", "collision_mask", "collide_with_bodies", "collide_with_areas", "results", "max_results", "result_count"),
+		NewEngineVirtualMethod[PhysicsDirectSpaceState3DExtensionRestInfoVirtual]("_rest_info", "shape_rid", "transform", "motion", "margin", "collision_mask", "collide_with_bodies", "collide_with_areas", "rest_info"),
+		NewEngineVirtualMethod[PhysicsDirectSpaceState3DExtensionGetClosestPointToObjectVolumeVirtual]("_get_closest_point_to_object_volume", "object", "point"),
+	})
+	GDEngineVirtualMethods.Set("PhysicsServer2DExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[PhysicsServer2DExtensionWorldBoundaryShapeCreateVirtual]("_world_boundary_shape_create"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionSeparationRayShapeCreateVirtual]("_separation_ray_shape_create"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionSegmentShapeCreateVirtual]("_segment_shape_create"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionCircleShapeCreateVirtual]("_circle_shape_create"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionRectangleShapeCreateVirtual]("_rectangle_shape_create"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionCapsuleShapeCreateVirtual]("_capsule_shape_create"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionConvexPolygonShapeCreateVirtual]("_convex_polygon_shape_create"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionConcavePolygonShapeCreateVirtual]("_concave_polygon_shape_create"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionShapeSetDataVirtual]("_shape_set_data", "shape", "data"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionShapeSetCustomSolverBiasVirtual]("_shape_set_custom_solver_bias", "shape", "bias"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionShapeGetTypeVirtual]("_shape_get_type", "shape"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionShapeGetDataVirtual]("_shape_get_data", "shape"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionShapeGetCustomSolverBiasVirtual]("_shape_get_custom_solver_bias", "shape"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionShapeCollideVirtual]("_shape_collide", "shape_A", "xform_A", "motion_A", "shape_B", "xform_B", "motion_B", "results", "result_max", "result_count"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionSpaceCreateVirtual]("_space_create"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionSpaceSetActiveVirtual]("_space_set_active", "space", "active"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionSpaceIsActiveVirtual]("_space_is_active", "space"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionSpaceSetParamVirtual]("_space_set_param", "space", "param", "value"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionSpaceGetParamVirtual]("_space_get_param", "space", "param"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionSpaceGetDirectStateVirtual]("_space_get_direct_state", "space"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionSpaceSetDebugContactsVirtual]("_space_set_debug_contacts", "space", "max_contacts"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionSpaceGetContactsVirtual]("_space_get_contacts", "space"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionSpaceGetContactCountVirtual]("_space_get_contact_count", "space"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaCreateVirtual]("_area_create"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaSetSpaceVirtual]("_area_set_space", "area", "space"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaGetSpaceVirtual]("_area_get_space", "area"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaAddShapeVirtual]("_area_add_shape", "area", "shape", "transform", "disabled"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaSetShapeVirtual]("_area_set_shape", "area", "shape_idx", "shape"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaSetShapeTransformVirtual]("_area_set_shape_transform", "area", "shape_idx", "transform"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaSetShapeDisabledVirtual]("_area_set_shape_disabled", "area", "shape_idx", "disabled"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaGetShapeCountVirtual]("_area_get_shape_count", "area"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaGetShapeVirtual]("_area_get_shape", "area", "shape_idx"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaGetShapeTransformVirtual]("_area_get_shape_transform", "area", "shape_idx"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaRemoveShapeVirtual]("_area_remove_shape", "area", "shape_idx"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaClearShapesVirtual]("_area_clear_shapes", "area"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaAttachObjectInstanceIdVirtual]("_area_attach_object_instance_id", "area", "id"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaGetObjectInstanceIdVirtual]("_area_get_object_instance_id", "area"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaAttachCanvasInstanceIdVirtual]("_area_attach_canvas_instance_id", "area", "id"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaGetCanvasInstanceIdVirtual]("_area_get_canvas_instance_id", "area"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaSetParamVirtual]("_area_set_param", "area", "param", "value"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaSetTransformVirtual]("_area_set_transform", "area", "transform"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaGetParamVirtual]("_area_get_param", "area", "param"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaGetTransformVirtual]("_area_get_transform", "area"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaSetCollisionLayerVirtual]("_area_set_collision_layer", "area", "layer"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaGetCollisionLayerVirtual]("_area_get_collision_layer", "area"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaSetCollisionMaskVirtual]("_area_set_collision_mask", "area", "mask"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaGetCollisionMaskVirtual]("_area_get_collision_mask", "area"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaSetMonitorableVirtual]("_area_set_monitorable", "area", "monitorable"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaSetPickableVirtual]("_area_set_pickable", "area", "pickable"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaSetMonitorCallbackVirtual]("_area_set_monitor_callback", "area", "callback"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionAreaSetAreaMonitorCallbackVirtual]("_area_set_area_monitor_callback", "area", "callback"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyCreateVirtual]("_body_create"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetSpaceVirtual]("_body_set_space", "body", "space"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyGetSpaceVirtual]("_body_get_space", "body"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetModeVirtual]("_body_set_mode", "body", "mode"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyGetModeVirtual]("_body_get_mode", "body"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyAddShapeVirtual]("_body_add_shape", "body", "shape", "transform", "disabled"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetShapeVirtual]("_body_set_shape", "body", "shape_idx", "shape"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetShapeTransformVirtual]("_body_set_shape_transform", "body", "shape_idx", "transform"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyGetShapeCountVirtual]("_body_get_shape_count", "body"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyGetShapeVirtual]("_body_get_shape", "body", "shape_idx"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyGetShapeTransformVirtual]("_body_get_shape_transform", "body", "shape_idx"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetShapeDisabledVirtual]("_body_set_shape_disabled", "body", "shape_idx", "disabled"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetShapeAsOneWayCollisionVirtual]("_body_set_shape_as_one_way_collision", "body", "shape_idx", "enable", "margin"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyRemoveShapeVirtual]("_body_remove_shape", "body", "shape_idx"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyClearShapesVirtual]("_body_clear_shapes", "body"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyAttachObjectInstanceIdVirtual]("_body_attach_object_instance_id", "body", "id"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyGetObjectInstanceIdVirtual]("_body_get_object_instance_id", "body"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyAttachCanvasInstanceIdVirtual]("_body_attach_canvas_instance_id", "body", "id"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyGetCanvasInstanceIdVirtual]("_body_get_canvas_instance_id", "body"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetContinuousCollisionDetectionModeVirtual]("_body_set_continuous_collision_detection_mode", "body", "mode"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyGetContinuousCollisionDetectionModeVirtual]("_body_get_continuous_collision_detection_mode", "body"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetCollisionLayerVirtual]("_body_set_collision_layer", "body", "layer"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyGetCollisionLayerVirtual]("_body_get_collision_layer", "body"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetCollisionMaskVirtual]("_body_set_collision_mask", "body", "mask"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyGetCollisionMaskVirtual]("_body_get_collision_mask", "body"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetCollisionPriorityVirtual]("_body_set_collision_priority", "body", "priority"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyGetCollisionPriorityVirtual]("_body_get_collision_priority", "body"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetParamVirtual]("_body_set_param", "body", "param", "value"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyGetParamVirtual]("_body_get_param", "body", "param"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyResetMassPropertiesVirtual]("_body_reset_mass_properties", "body"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetStateVirtual]("_body_set_state", "body", "state", "value"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyGetStateVirtual]("_body_get_state", "body", "state"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyApplyCentralImpulseVirtual]("_body_apply_central_impulse", "body", "impulse"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyApplyTorqueImpulseVirtual]("_body_apply_torque_impulse", "body", "impulse"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyApplyImpulseVirtual]("_body_apply_impulse", "body", "impulse", "position"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyApplyCentralForceVirtual]("_body_apply_central_force", "body", "force"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyApplyForceVirtual]("_body_apply_force", "body", "force", "position"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyApplyTorqueVirtual]("_body_apply_torque", "body", "torque"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyAddConstantCentralForceVirtual]("_body_add_constant_central_force", "body", "force"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyAddConstantForceVirtual]("_body_add_constant_force", "body", "force", "position"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyAddConstantTorqueVirtual]("_body_add_constant_torque", "body", "torque"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetConstantForceVirtual]("_body_set_constant_force", "body", "force"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyGetConstantForceVirtual]("_body_get_constant_force", "body"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetConstantTorqueVirtual]("_body_set_constant_torque", "body", "torque"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyGetConstantTorqueVirtual]("_body_get_constant_torque", "body"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetAxisVelocityVirtual]("_body_set_axis_velocity", "body", "axis_velocity"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyAddCollisionExceptionVirtual]("_body_add_collision_exception", "body", "excepted_body"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyRemoveCollisionExceptionVirtual]("_body_remove_collision_exception", "body", "excepted_body"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyGetCollisionExceptionsVirtual]("_body_get_collision_exceptions", "body"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetMaxContactsReportedVirtual]("_body_set_max_contacts_reported", "body", "amount"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyGetMaxContactsReportedVirtual]("_body_get_max_contacts_reported", "body"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetContactsReportedDepthThresholdVirtual]("_body_set_contacts_reported_depth_threshold", "body", "threshold"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyGetContactsReportedDepthThresholdVirtual]("_body_get_contacts_reported_depth_threshold", "body"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetOmitForceIntegrationVirtual]("_body_set_omit_force_integration", "body", "enable"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyIsOmittingForceIntegrationVirtual]("_body_is_omitting_force_integration", "body"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetStateSyncCallbackVirtual]("_body_set_state_sync_callback", "body", "callable"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetForceIntegrationCallbackVirtual]("_body_set_force_integration_callback", "body", "callable", "userdata"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyCollideShapeVirtual]("_body_collide_shape", "body", "body_shape", "shape", "shape_xform", "motion", "results", "result_max", "result_count"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodySetPickableVirtual]("_body_set_pickable", "body", "pickable"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyGetDirectStateVirtual]("_body_get_direct_state", "body"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionBodyTestMotionVirtual]("_body_test_motion", "body", "from", "motion", "margin", "collide_separation_ray", "recovery_as_collision", "result"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionJointCreateVirtual]("_joint_create"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionJointClearVirtual]("_joint_clear", "joint"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionJointSetParamVirtual]("_joint_set_param", "joint", "param", "value"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionJointGetParamVirtual]("_joint_get_param", "joint", "param"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionJointDisableCollisionsBetweenBodiesVirtual]("_joint_disable_collisions_between_bodies", "joint", "disable"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionJointIsDisabledCollisionsBetweenBodiesVirtual]("_joint_is_disabled_collisions_between_bodies", "joint"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionJointMakePinVirtual]("_joint_make_pin", "joint", "anchor", "body_a", "body_b"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionJointMakeGrooveVirtual]("_joint_make_groove", "joint", "a_groove1", "a_groove2", "b_anchor", "body_a", "body_b"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionJointMakeDampedSpringVirtual]("_joint_make_damped_spring", "joint", "anchor_a", "anchor_b", "body_a", "body_b"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionPinJointSetFlagVirtual]("_pin_joint_set_flag", "joint", "flag", "enabled"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionPinJointGetFlagVirtual]("_pin_joint_get_flag", "joint", "flag"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionPinJointSetParamVirtual]("_pin_joint_set_param", "joint", "param", "value"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionPinJointGetParamVirtual]("_pin_joint_get_param", "joint", "param"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionDampedSpringJointSetParamVirtual]("_damped_spring_joint_set_param", "joint", "param", "value"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionDampedSpringJointGetParamVirtual]("_damped_spring_joint_get_param", "joint", "param"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionJointGetTypeVirtual]("_joint_get_type", "joint"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionFreeRidVirtual]("_free_rid", "rid"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionSetActiveVirtual]("_set_active", "active"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionInitVirtual]("_init"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionStepVirtual]("_step", "step"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionSyncVirtual]("_sync"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionFlushQueriesVirtual]("_flush_queries"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionEndSyncVirtual]("_end_sync"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionFinishVirtual]("_finish"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionIsFlushingQueriesVirtual]("_is_flushing_queries"),
+		NewEngineVirtualMethod[PhysicsServer2DExtensionGetProcessInfoVirtual]("_get_process_info", "process_info"),
+	})
+	GDEngineVirtualMethods.Set("PhysicsServer3DExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[PhysicsServer3DExtensionWorldBoundaryShapeCreateVirtual]("_world_boundary_shape_create"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSeparationRayShapeCreateVirtual]("_separation_ray_shape_create"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSphereShapeCreateVirtual]("_sphere_shape_create"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBoxShapeCreateVirtual]("_box_shape_create"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionCapsuleShapeCreateVirtual]("_capsule_shape_create"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionCylinderShapeCreateVirtual]("_cylinder_shape_create"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionConvexPolygonShapeCreateVirtual]("_convex_polygon_shape_create"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionConcavePolygonShapeCreateVirtual]("_concave_polygon_shape_create"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionHeightmapShapeCreateVirtual]("_heightmap_shape_create"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionCustomShapeCreateVirtual]("_custom_shape_create"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionShapeSetDataVirtual]("_shape_set_data", "shape", "data"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionShapeSetCustomSolverBiasVirtual]("_shape_set_custom_solver_bias", "shape", "bias"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionShapeSetMarginVirtual]("_shape_set_margin", "shape", "margin"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionShapeGetMarginVirtual]("_shape_get_margin", "shape"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionShapeGetTypeVirtual]("_shape_get_type", "shape"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionShapeGetDataVirtual]("_shape_get_data", "shape"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionShapeGetCustomSolverBiasVirtual]("_shape_get_custom_solver_bias", "shape"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSpaceCreateVirtual]("_space_create"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSpaceSetActiveVirtual]("_space_set_active", "space", "active"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSpaceIsActiveVirtual]("_space_is_active", "space"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSpaceSetParamVirtual]("_space_set_param", "space", "param", "value"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSpaceGetParamVirtual]("_space_get_param", "space", "param"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSpaceGetDirectStateVirtual]("_space_get_direct_state", "space"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSpaceSetDebugContactsVirtual]("_space_set_debug_contacts", "space", "max_contacts"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSpaceGetContactsVirtual]("_space_get_contacts", "space"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSpaceGetContactCountVirtual]("_space_get_contact_count", "space"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaCreateVirtual]("_area_create"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaSetSpaceVirtual]("_area_set_space", "area", "space"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaGetSpaceVirtual]("_area_get_space", "area"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaAddShapeVirtual]("_area_add_shape", "area", "shape", "transform", "disabled"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaSetShapeVirtual]("_area_set_shape", "area", "shape_idx", "shape"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaSetShapeTransformVirtual]("_area_set_shape_transform", "area", "shape_idx", "transform"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaSetShapeDisabledVirtual]("_area_set_shape_disabled", "area", "shape_idx", "disabled"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaGetShapeCountVirtual]("_area_get_shape_count", "area"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaGetShapeVirtual]("_area_get_shape", "area", "shape_idx"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaGetShapeTransformVirtual]("_area_get_shape_transform", "area", "shape_idx"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaRemoveShapeVirtual]("_area_remove_shape", "area", "shape_idx"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaClearShapesVirtual]("_area_clear_shapes", "area"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaAttachObjectInstanceIdVirtual]("_area_attach_object_instance_id", "area", "id"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaGetObjectInstanceIdVirtual]("_area_get_object_instance_id", "area"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaSetParamVirtual]("_area_set_param", "area", "param", "value"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaSetTransformVirtual]("_area_set_transform", "area", "transform"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaGetParamVirtual]("_area_get_param", "area", "param"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaGetTransformVirtual]("_area_get_transform", "area"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaSetCollisionLayerVirtual]("_area_set_collision_layer", "area", "layer"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaGetCollisionLayerVirtual]("_area_get_collision_layer", "area"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaSetCollisionMaskVirtual]("_area_set_collision_mask", "area", "mask"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaGetCollisionMaskVirtual]("_area_get_collision_mask", "area"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaSetMonitorableVirtual]("_area_set_monitorable", "area", "monitorable"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaSetRayPickableVirtual]("_area_set_ray_pickable", "area", "enable"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaSetMonitorCallbackVirtual]("_area_set_monitor_callback", "area", "callback"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionAreaSetAreaMonitorCallbackVirtual]("_area_set_area_monitor_callback", "area", "callback"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyCreateVirtual]("_body_create"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetSpaceVirtual]("_body_set_space", "body", "space"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyGetSpaceVirtual]("_body_get_space", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetModeVirtual]("_body_set_mode", "body", "mode"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyGetModeVirtual]("_body_get_mode", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyAddShapeVirtual]("_body_add_shape", "body", "shape", "transform", "disabled"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetShapeVirtual]("_body_set_shape", "body", "shape_idx", "shape"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetShapeTransformVirtual]("_body_set_shape_transform", "body", "shape_idx", "transform"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetShapeDisabledVirtual]("_body_set_shape_disabled", "body", "shape_idx", "disabled"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyGetShapeCountVirtual]("_body_get_shape_count", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyGetShapeVirtual]("_body_get_shape", "body", "shape_idx"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyGetShapeTransformVirtual]("_body_get_shape_transform", "body", "shape_idx"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyRemoveShapeVirtual]("_body_remove_shape", "body", "shape_idx"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyClearShapesVirtual]("_body_clear_shapes", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyAttachObjectInstanceIdVirtual]("_body_attach_object_instance_id", "body", "id"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyGetObjectInstanceIdVirtual]("_body_get_object_instance_id", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetEnableContinuousCollisionDetectionVirtual]("_body_set_enable_continuous_collision_detection", "body", "enable"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyIsContinuousCollisionDetectionEnabledVirtual]("_body_is_continuous_collision_detection_enabled", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetCollisionLayerVirtual]("_body_set_collision_layer", "body", "layer"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyGetCollisionLayerVirtual]("_body_get_collision_layer", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetCollisionMaskVirtual]("_body_set_collision_mask", "body", "mask"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyGetCollisionMaskVirtual]("_body_get_collision_mask", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetCollisionPriorityVirtual]("_body_set_collision_priority", "body", "priority"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyGetCollisionPriorityVirtual]("_body_get_collision_priority", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetUserFlagsVirtual]("_body_set_user_flags", "body", "flags"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyGetUserFlagsVirtual]("_body_get_user_flags", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetParamVirtual]("_body_set_param", "body", "param", "value"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyGetParamVirtual]("_body_get_param", "body", "param"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyResetMassPropertiesVirtual]("_body_reset_mass_properties", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetStateVirtual]("_body_set_state", "body", "state", "value"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyGetStateVirtual]("_body_get_state", "body", "state"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyApplyCentralImpulseVirtual]("_body_apply_central_impulse", "body", "impulse"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyApplyImpulseVirtual]("_body_apply_impulse", "body", "impulse", "position"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyApplyTorqueImpulseVirtual]("_body_apply_torque_impulse", "body", "impulse"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyApplyCentralForceVirtual]("_body_apply_central_force", "body", "force"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyApplyForceVirtual]("_body_apply_force", "body", "force", "position"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyApplyTorqueVirtual]("_body_apply_torque", "body", "torque"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyAddConstantCentralForceVirtual]("_body_add_constant_central_force", "body", "force"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyAddConstantForceVirtual]("_body_add_constant_force", "body", "force", "position"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyAddConstantTorqueVirtual]("_body_add_constant_torque", "body", "torque"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetConstantForceVirtual]("_body_set_constant_force", "body", "force"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyGetConstantForceVirtual]("_body_get_constant_force", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetConstantTorqueVirtual]("_body_set_constant_torque", "body", "torque"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyGetConstantTorqueVirtual]("_body_get_constant_torque", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetAxisVelocityVirtual]("_body_set_axis_velocity", "body", "axis_velocity"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetAxisLockVirtual]("_body_set_axis_lock", "body", "axis", "lock"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyIsAxisLockedVirtual]("_body_is_axis_locked", "body", "axis"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyAddCollisionExceptionVirtual]("_body_add_collision_exception", "body", "excepted_body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyRemoveCollisionExceptionVirtual]("_body_remove_collision_exception", "body", "excepted_body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyGetCollisionExceptionsVirtual]("_body_get_collision_exceptions", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetMaxContactsReportedVirtual]("_body_set_max_contacts_reported", "body", "amount"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyGetMaxContactsReportedVirtual]("_body_get_max_contacts_reported", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetContactsReportedDepthThresholdVirtual]("_body_set_contacts_reported_depth_threshold", "body", "threshold"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyGetContactsReportedDepthThresholdVirtual]("_body_get_contacts_reported_depth_threshold", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetOmitForceIntegrationVirtual]("_body_set_omit_force_integration", "body", "enable"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyIsOmittingForceIntegrationVirtual]("_body_is_omitting_force_integration", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetStateSyncCallbackVirtual]("_body_set_state_sync_callback", "body", "callable"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetForceIntegrationCallbackVirtual]("_body_set_force_integration_callback", "body", "callable", "userdata"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodySetRayPickableVirtual]("_body_set_ray_pickable", "body", "enable"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyTestMotionVirtual]("_body_test_motion", "body", "from", "motion", "margin", "max_collisions", "collide_separation_ray", "recovery_as_collision", "result"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionBodyGetDirectStateVirtual]("_body_get_direct_state", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyCreateVirtual]("_soft_body_create"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyUpdateRenderingServerVirtual]("_soft_body_update_rendering_server", "body", "rendering_server_handler"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodySetSpaceVirtual]("_soft_body_set_space", "body", "space"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyGetSpaceVirtual]("_soft_body_get_space", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodySetRayPickableVirtual]("_soft_body_set_ray_pickable", "body", "enable"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodySetCollisionLayerVirtual]("_soft_body_set_collision_layer", "body", "layer"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyGetCollisionLayerVirtual]("_soft_body_get_collision_layer", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodySetCollisionMaskVirtual]("_soft_body_set_collision_mask", "body", "mask"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyGetCollisionMaskVirtual]("_soft_body_get_collision_mask", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyAddCollisionExceptionVirtual]("_soft_body_add_collision_exception", "body", "body_b"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyRemoveCollisionExceptionVirtual]("_soft_body_remove_collision_exception", "body", "body_b"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyGetCollisionExceptionsVirtual]("_soft_body_get_collision_exceptions", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodySetStateVirtual]("_soft_body_set_state", "body", "state", "variant"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyGetStateVirtual]("_soft_body_get_state", "body", "state"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodySetTransformVirtual]("_soft_body_set_transform", "body", "transform"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodySetSimulationPrecisionVirtual]("_soft_body_set_simulation_precision", "body", "simulation_precision"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyGetSimulationPrecisionVirtual]("_soft_body_get_simulation_precision", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodySetTotalMassVirtual]("_soft_body_set_total_mass", "body", "total_mass"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyGetTotalMassVirtual]("_soft_body_get_total_mass", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodySetLinearStiffnessVirtual]("_soft_body_set_linear_stiffness", "body", "linear_stiffness"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyGetLinearStiffnessVirtual]("_soft_body_get_linear_stiffness", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodySetShrinkingFactorVirtual]("_soft_body_set_shrinking_factor", "body", "shrinking_factor"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyGetShrinkingFactorVirtual]("_soft_body_get_shrinking_factor", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodySetPressureCoefficientVirtual]("_soft_body_set_pressure_coefficient", "body", "pressure_coefficient"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyGetPressureCoefficientVirtual]("_soft_body_get_pressure_coefficient", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodySetDampingCoefficientVirtual]("_soft_body_set_damping_coefficient", "body", "damping_coefficient"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyGetDampingCoefficientVirtual]("_soft_body_get_damping_coefficient", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodySetDragCoefficientVirtual]("_soft_body_set_drag_coefficient", "body", "drag_coefficient"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyGetDragCoefficientVirtual]("_soft_body_get_drag_coefficient", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodySetMeshVirtual]("_soft_body_set_mesh", "body", "mesh"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyGetBoundsVirtual]("_soft_body_get_bounds", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyMovePointVirtual]("_soft_body_move_point", "body", "point_index", "global_position"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyGetPointGlobalPositionVirtual]("_soft_body_get_point_global_position", "body", "point_index"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyRemoveAllPinnedPointsVirtual]("_soft_body_remove_all_pinned_points", "body"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyPinPointVirtual]("_soft_body_pin_point", "body", "point_index", "pin"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyIsPointPinnedVirtual]("_soft_body_is_point_pinned", "body", "point_index"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyApplyPointImpulseVirtual]("_soft_body_apply_point_impulse", "body", "point_index", "impulse"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyApplyPointForceVirtual]("_soft_body_apply_point_force", "body", "point_index", "force"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyApplyCentralImpulseVirtual]("_soft_body_apply_central_impulse", "body", "impulse"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSoftBodyApplyCentralForceVirtual]("_soft_body_apply_central_force", "body", "force"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionJointCreateVirtual]("_joint_create"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionJointClearVirtual]("_joint_clear", "joint"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionJointMakePinVirtual]("_joint_make_pin", "joint", "body_A", "local_A", "body_B", "local_B"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionPinJointSetParamVirtual]("_pin_joint_set_param", "joint", "param", "value"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionPinJointGetParamVirtual]("_pin_joint_get_param", "joint", "param"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionPinJointSetLocalAVirtual]("_pin_joint_set_local_a", "joint", "local_A"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionPinJointGetLocalAVirtual]("_pin_joint_get_local_a", "joint"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionPinJointSetLocalBVirtual]("_pin_joint_set_local_b", "joint", "local_B"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionPinJointGetLocalBVirtual]("_pin_joint_get_local_b", "joint"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionJointMakeHingeVirtual]("_joint_make_hinge", "joint", "body_A", "hinge_A", "body_B", "hinge_B"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionJointMakeHingeSimpleVirtual]("_joint_make_hinge_simple", "joint", "body_A", "pivot_A", "axis_A", "body_B", "pivot_B", "axis_B"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionHingeJointSetParamVirtual]("_hinge_joint_set_param", "joint", "param", "value"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionHingeJointGetParamVirtual]("_hinge_joint_get_param", "joint", "param"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionHingeJointSetFlagVirtual]("_hinge_joint_set_flag", "joint", "flag", "enabled"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionHingeJointGetFlagVirtual]("_hinge_joint_get_flag", "joint", "flag"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionJointMakeSliderVirtual]("_joint_make_slider", "joint", "body_A", "local_ref_A", "body_B", "local_ref_B"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSliderJointSetParamVirtual]("_slider_joint_set_param", "joint", "param", "value"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSliderJointGetParamVirtual]("_slider_joint_get_param", "joint", "param"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionJointMakeConeTwistVirtual]("_joint_make_cone_twist", "joint", "body_A", "local_ref_A", "body_B", "local_ref_B"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionConeTwistJointSetParamVirtual]("_cone_twist_joint_set_param", "joint", "param", "value"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionConeTwistJointGetParamVirtual]("_cone_twist_joint_get_param", "joint", "param"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionJointMakeGeneric6DofVirtual]("_joint_make_generic_6dof", "joint", "body_A", "local_ref_A", "body_B", "local_ref_B"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionGeneric6DofJointSetParamVirtual]("_generic_6dof_joint_set_param", "joint", "axis", "param", "value"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionGeneric6DofJointGetParamVirtual]("_generic_6dof_joint_get_param", "joint", "axis", "param"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionGeneric6DofJointSetFlagVirtual]("_generic_6dof_joint_set_flag", "joint", "axis", "flag", "enable"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionGeneric6DofJointGetFlagVirtual]("_generic_6dof_joint_get_flag", "joint", "axis", "flag"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionJointGetTypeVirtual]("_joint_get_type", "joint"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionJointSetSolverPriorityVirtual]("_joint_set_solver_priority", "joint", "priority"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionJointGetSolverPriorityVirtual]("_joint_get_solver_priority", "joint"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionJointDisableCollisionsBetweenBodiesVirtual]("_joint_disable_collisions_between_bodies", "joint", "disable"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionJointIsDisabledCollisionsBetweenBodiesVirtual]("_joint_is_disabled_collisions_between_bodies", "joint"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionFreeRidVirtual]("_free_rid", "rid"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSetActiveVirtual]("_set_active", "active"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionInitVirtual]("_init"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionStepVirtual]("_step", "step"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionSyncVirtual]("_sync"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionFlushQueriesVirtual]("_flush_queries"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionEndSyncVirtual]("_end_sync"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionFinishVirtual]("_finish"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionIsFlushingQueriesVirtual]("_is_flushing_queries"),
+		NewEngineVirtualMethod[PhysicsServer3DExtensionGetProcessInfoVirtual]("_get_process_info", "process_info"),
+	})
+	GDEngineVirtualMethods.Set("PhysicsServer3DRenderingServerHandler", []EngineVirtualMethod{
+		NewEngineVirtualMethod[PhysicsServer3DRenderingServerHandlerSetVertexVirtual]("_set_vertex", "vertex_id", "vertex"),
+		NewEngineVirtualMethod[PhysicsServer3DRenderingServerHandlerSetNormalVirtual]("_set_normal", "vertex_id", "normal"),
+		NewEngineVirtualMethod[PhysicsServer3DRenderingServerHandlerSetAabbVirtual]("_set_aabb", "aabb"),
+	})
+	GDEngineVirtualMethods.Set("PrimitiveMesh", []EngineVirtualMethod{
+		NewEngineVirtualMethod[PrimitiveMeshCreateMeshArrayVirtual]("_create_mesh_array"),
+	})
+	GDEngineVirtualMethods.Set("Range", []EngineVirtualMethod{
+		NewEngineVirtualMethod[RangeValueChangedVirtual]("_value_changed", "new_value"),
+	})
+	GDEngineVirtualMethods.Set("RenderDataExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[RenderDataExtensionGetRenderSceneBuffersVirtual]("_get_render_scene_buffers"),
+		NewEngineVirtualMethod[RenderDataExtensionGetRenderSceneDataVirtual]("_get_render_scene_data"),
+		NewEngineVirtualMethod[RenderDataExtensionGetEnvironmentVirtual]("_get_environment"),
+		NewEngineVirtualMethod[RenderDataExtensionGetCameraAttributesVirtual]("_get_camera_attributes"),
+	})
+	GDEngineVirtualMethods.Set("RenderSceneBuffersExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[RenderSceneBuffersExtensionConfigureVirtual]("_configure", "config"),
+		NewEngineVirtualMethod[RenderSceneBuffersExtensionSetFsrSharpnessVirtual]("_set_fsr_sharpness", "fsr_sharpness"),
+		NewEngineVirtualMethod[RenderSceneBuffersExtensionSetTextureMipmapBiasVirtual]("_set_texture_mipmap_bias", "texture_mipmap_bias"),
+		NewEngineVirtualMethod[RenderSceneBuffersExtensionSetAnisotropicFilteringLevelVirtual]("_set_anisotropic_filtering_level", "anisotropic_filtering_level"),
+		NewEngineVirtualMethod[RenderSceneBuffersExtensionSetUseDebandingVirtual]("_set_use_debanding", "use_debanding"),
+	})
+	GDEngineVirtualMethods.Set("RenderSceneDataExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[RenderSceneDataExtensionGetCamTransformVirtual]("_get_cam_transform"),
+		NewEngineVirtualMethod[RenderSceneDataExtensionGetCamProjectionVirtual]("_get_cam_projection"),
+		NewEngineVirtualMethod[RenderSceneDataExtensionGetViewCountVirtual]("_get_view_count"),
+		NewEngineVirtualMethod[RenderSceneDataExtensionGetViewEyeOffsetVirtual]("_get_view_eye_offset", "view"),
+		NewEngineVirtualMethod[RenderSceneDataExtensionGetViewProjectionVirtual]("_get_view_projection", "view"),
+		NewEngineVirtualMethod[RenderSceneDataExtensionGetUniformBufferVirtual]("_get_uniform_buffer"),
+	})
+	GDEngineVirtualMethods.Set("Resource", []EngineVirtualMethod{
+		NewEngineVirtualMethod[ResourceSetupLocalToSceneVirtual]("_setup_local_to_scene"),
+		NewEngineVirtualMethod[ResourceGetRidVirtual]("_get_rid"),
+		NewEngineVirtualMethod[ResourceResetStateVirtual]("_reset_state"),
+		NewEngineVirtualMethod[ResourceSetPathCacheVirtual]("_set_path_cache", "path"),
+	})
+	GDEngineVirtualMethods.Set("ResourceFormatLoader", []EngineVirtualMethod{
+		NewEngineVirtualMethod[ResourceFormatLoaderGetRecognizedExtensionsVirtual]("_get_recognized_extensions"),
+		NewEngineVirtualMethod[ResourceFormatLoaderRecognizePathVirtual]("_recognize_path", "path", "type"),
+		NewEngineVirtualMethod[ResourceFormatLoaderHandlesTypeVirtual]("_handles_type", "type"),
+		NewEngineVirtualMethod[ResourceFormatLoaderGetResourceTypeVirtual]("_get_resource_type", "path"),
+		NewEngineVirtualMethod[ResourceFormatLoaderGetResourceScriptClassVirtual]("_get_resource_script_class", "path"),
+		NewEngineVirtualMethod[ResourceFormatLoaderGetResourceUidVirtual]("_get_resource_uid", "path"),
+		NewEngineVirtualMethod[ResourceFormatLoaderGetDependenciesVirtual]("_get_dependencies", "path", "add_types"),
+		NewEngineVirtualMethod[ResourceFormatLoaderRenameDependenciesVirtual]("_rename_dependencies", "path", "renames"),
+		NewEngineVirtualMethod[ResourceFormatLoaderExistsVirtual]("_exists", "path"),
+		NewEngineVirtualMethod[ResourceFormatLoaderGetClassesUsedVirtual]("_get_classes_used", "path"),
+		NewEngineVirtualMethod[ResourceFormatLoaderLoadVirtual]("_load", "path", "original_path", "use_sub_threads", "cache_mode"),
+	})
+	GDEngineVirtualMethods.Set("ResourceFormatSaver", []EngineVirtualMethod{
+		NewEngineVirtualMethod[ResourceFormatSaverSaveVirtual]("_save", "resource", "path", "flags"),
+		NewEngineVirtualMethod[ResourceFormatSaverSetUidVirtual]("_set_uid", "path", "uid"),
+		NewEngineVirtualMethod[ResourceFormatSaverRecognizeVirtual]("_recognize", "resource"),
+		NewEngineVirtualMethod[ResourceFormatSaverGetRecognizedExtensionsVirtual]("_get_recognized_extensions", "resource"),
+		NewEngineVirtualMethod[ResourceFormatSaverRecognizePathVirtual]("_recognize_path", "resource", "path"),
+	})
+	GDEngineVirtualMethods.Set("ResourceImporter", []EngineVirtualMethod{
+		NewEngineVirtualMethod[ResourceImporterGetBuildDependenciesVirtual]("_get_build_dependencies", "path"),
+	})
+	GDEngineVirtualMethods.Set("RichTextEffect", []EngineVirtualMethod{
+		NewEngineVirtualMethod[RichTextEffectProcessCustomFxVirtual]("_process_custom_fx", "char_fx"),
+	})
+	GDEngineVirtualMethods.Set("RigidBody2D", []EngineVirtualMethod{
+		NewEngineVirtualMethod[RigidBody2DIntegrateForcesVirtual]("_integrate_forces", "state"),
+	})
+	GDEngineVirtualMethods.Set("RigidBody3D", []EngineVirtualMethod{
+		NewEngineVirtualMethod[RigidBody3DIntegrateForcesVirtual]("_integrate_forces", "state"),
+	})
+	GDEngineVirtualMethods.Set("ScriptExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[ScriptExtensionEditorCanReloadFromFileVirtual]("_editor_can_reload_from_file"),
+		NewEngineVirtualMethod[ScriptExtensionPlaceholderErasedVirtual]("_placeholder_erased", "placeholder"),
+		NewEngineVirtualMethod[ScriptExtensionCanInstantiateVirtual]("_can_instantiate"),
+		NewEngineVirtualMethod[ScriptExtensionGetBaseScriptVirtual]("_get_base_script"),
+		NewEngineVirtualMethod[ScriptExtensionGetGlobalNameVirtual]("_get_global_name"),
+		NewEngineVirtualMethod[ScriptExtensionInheritsScriptVirtual]("_inherits_script", "script"),
+		NewEngineVirtualMethod[ScriptExtensionGetInstanceBaseTypeVirtual]("_get_instance_base_type"),
+		NewEngineVirtualMethod[ScriptExtensionInstanceCreateVirtual]("_instance_create", "for_object"),
+		NewEngineVirtualMethod[ScriptExtensionPlaceholderInstanceCreateVirtual]("_placeholder_instance_create", "for_object"),
+		NewEngineVirtualMethod[ScriptExtensionInstanceHasVirtual]("_instance_has", "object"),
+		NewEngineVirtualMethod[ScriptExtensionHasSourceCodeVirtual]("_has_source_code"),
+		NewEngineVirtualMethod[ScriptExtensionGetSourceCodeVirtual]("_get_source_code"),
+		NewEngineVirtualMethod[ScriptExtensionSetSourceCodeVirtual]("_set_source_code", "code"),
+		NewEngineVirtualMethod[ScriptExtensionReloadVirtual]("_reload", "keep_state"),
+		NewEngineVirtualMethod[ScriptExtensionGetDocClassNameVirtual]("_get_doc_class_name"),
+		NewEngineVirtualMethod[ScriptExtensionGetDocumentationVirtual]("_get_documentation"),
+		NewEngineVirtualMethod[ScriptExtensionGetClassIconPathVirtual]("_get_class_icon_path"),
+		NewEngineVirtualMethod[ScriptExtensionHasMethodVirtual]("_has_method", "method"),
+		NewEngineVirtualMethod[ScriptExtensionHasStaticMethodVirtual]("_has_static_method", "method"),
+		NewEngineVirtualMethod[ScriptExtensionGetScriptMethodArgumentCountVirtual]("_get_script_method_argument_count", "method"),
+		NewEngineVirtualMethod[ScriptExtensionGetMethodInfoVirtual]("_get_method_info", "method"),
+		NewEngineVirtualMethod[ScriptExtensionIsToolVirtual]("_is_tool"),
+		NewEngineVirtualMethod[ScriptExtensionIsValidVirtual]("_is_valid"),
+		NewEngineVirtualMethod[ScriptExtensionIsAbstractVirtual]("_is_abstract"),
+		NewEngineVirtualMethod[ScriptExtensionGetLanguageVirtual]("_get_language"),
+		NewEngineVirtualMethod[ScriptExtensionHasScriptSignalVirtual]("_has_script_signal", "signal"),
+		NewEngineVirtualMethod[ScriptExtensionGetScriptSignalListVirtual]("_get_script_signal_list"),
+		NewEngineVirtualMethod[ScriptExtensionHasPropertyDefaultValueVirtual]("_has_property_default_value", "property"),
+		NewEngineVirtualMethod[ScriptExtensionGetPropertyDefaultValueVirtual]("_get_property_default_value", "property"),
+		NewEngineVirtualMethod[ScriptExtensionUpdateExportsVirtual]("_update_exports"),
+		NewEngineVirtualMethod[ScriptExtensionGetScriptMethodListVirtual]("_get_script_method_list"),
+		NewEngineVirtualMethod[ScriptExtensionGetScriptPropertyListVirtual]("_get_script_property_list"),
+		NewEngineVirtualMethod[ScriptExtensionGetMemberLineVirtual]("_get_member_line", "member"),
+		NewEngineVirtualMethod[ScriptExtensionGetConstantsVirtual]("_get_constants"),
+		NewEngineVirtualMethod[ScriptExtensionGetMembersVirtual]("_get_members"),
+		NewEngineVirtualMethod[ScriptExtensionIsPlaceholderFallbackEnabledVirtual]("_is_placeholder_fallback_enabled"),
+		NewEngineVirtualMethod[ScriptExtensionGetRpcConfigVirtual]("_get_rpc_config"),
+	})
+	GDEngineVirtualMethods.Set("ScriptLanguageExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[ScriptLanguageExtensionGetNameVirtual]("_get_name"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionInitVirtual]("_init"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionGetTypeVirtual]("_get_type"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionGetExtensionVirtual]("_get_extension"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionFinishVirtual]("_finish"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionGetReservedWordsVirtual]("_get_reserved_words"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionIsControlFlowKeywordVirtual]("_is_control_flow_keyword", "keyword"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionGetCommentDelimitersVirtual]("_get_comment_delimiters"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionGetDocCommentDelimitersVirtual]("_get_doc_comment_delimiters"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionGetStringDelimitersVirtual]("_get_string_delimiters"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionMakeTemplateVirtual]("_make_template", "template", "class_name", "base_class_name"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionGetBuiltInTemplatesVirtual]("_get_built_in_templates", "object"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionIsUsingTemplatesVirtual]("_is_using_templates"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionValidateVirtual]("_validate", "script", "path", "validate_functions", "validate_errors", "validate_warnings", "validate_safe_lines"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionValidatePathVirtual]("_validate_path", "path"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionCreateScriptVirtual]("_create_script"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionHasNamedClassesVirtual]("_has_named_classes"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionSupportsBuiltinModeVirtual]("_supports_builtin_mode"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionSupportsDocumentationVirtual]("_supports_documentation"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionCanInheritFromFileVirtual]("_can_inherit_from_file"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionFindFunctionVirtual]("_find_function", "function", "code"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionMakeFunctionVirtual]("_make_function", "class_name", "function_name", "function_args"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionCanMakeFunctionVirtual]("_can_make_function"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionOpenInExternalEditorVirtual]("_open_in_external_editor", "script", "line", "column"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionOverridesExternalEditorVirtual]("_overrides_external_editor"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionPreferredFileNameCasingVirtual]("_preferred_file_name_casing"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionCompleteCodeVirtual]("_complete_code", "code", "path", "owner"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionLookupCodeVirtual]("_lookup_code", "code", "symbol", "path", "owner"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionAutoIndentCodeVirtual]("_auto_indent_code", "code", "from_line", "to_line"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionAddGlobalConstantVirtual]("_add_global_constant", "name", "value"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionAddNamedGlobalConstantVirtual]("_add_named_global_constant", "name", "value"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionRemoveNamedGlobalConstantVirtual]("_remove_named_global_constant", "name"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionThreadEnterVirtual]("_thread_enter"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionThreadExitVirtual]("_thread_exit"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionDebugGetErrorVirtual]("_debug_get_error"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionDebugGetStackLevelCountVirtual]("_debug_get_stack_level_count"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionDebugGetStackLevelLineVirtual]("_debug_get_stack_level_line", "level"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionDebugGetStackLevelFunctionVirtual]("_debug_get_stack_level_function", "level"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionDebugGetStackLevelSourceVirtual]("_debug_get_stack_level_source", "level"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionDebugGetStackLevelLocalsVirtual]("_debug_get_stack_level_locals", "level", "max_subitems", "max_depth"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionDebugGetStackLevelMembersVirtual]("_debug_get_stack_level_members", "level", "max_subitems", "max_depth"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionDebugGetStackLevelInstanceVirtual]("_debug_get_stack_level_instance", "level"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionDebugGetGlobalsVirtual]("_debug_get_globals", "max_subitems", "max_depth"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionDebugParseStackLevelExpressionVirtual]("_debug_parse_stack_level_expression", "level", "expression", "max_subitems", "max_depth"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionDebugGetCurrentStackInfoVirtual]("_debug_get_current_stack_info"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionReloadAllScriptsVirtual]("_reload_all_scripts"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionReloadScriptsVirtual]("_reload_scripts", "scripts", "soft_reload"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionReloadToolScriptVirtual]("_reload_tool_script", "script", "soft_reload"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionGetRecognizedExtensionsVirtual]("_get_recognized_extensions"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionGetPublicFunctionsVirtual]("_get_public_functions"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionGetPublicConstantsVirtual]("_get_public_constants"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionGetPublicAnnotationsVirtual]("_get_public_annotations"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionProfilingStartVirtual]("_profiling_start"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionProfilingStopVirtual]("_profiling_stop"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionProfilingSetSaveNativeCallsVirtual]("_profiling_set_save_native_calls", "enable"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionProfilingGetAccumulatedDataVirtual]("_profiling_get_accumulated_data", "info_array", "info_max"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionProfilingGetFrameDataVirtual]("_profiling_get_frame_data", "info_array", "info_max"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionFrameVirtual]("_frame"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionHandlesGlobalClassTypeVirtual]("_handles_global_class_type", "type"),
+		NewEngineVirtualMethod[ScriptLanguageExtensionGetGlobalClassNameVirtual]("_get_global_class_name", "path"),
+	})
+	GDEngineVirtualMethods.Set("SkeletonModification2D", []EngineVirtualMethod{
+		NewEngineVirtualMethod[SkeletonModification2DExecuteVirtual]("_execute", "delta"),
+		NewEngineVirtualMethod[SkeletonModification2DSetupModificationVirtual]("_setup_modification", "modification_stack"),
+		NewEngineVirtualMethod[SkeletonModification2DDrawEditorGizmoVirtual]("_draw_editor_gizmo"),
+	})
+	GDEngineVirtualMethods.Set("SkeletonModifier3D", []EngineVirtualMethod{
+		NewEngineVirtualMethod[SkeletonModifier3DProcessModificationWithDeltaVirtual]("_process_modification_with_delta", "delta"),
+		NewEngineVirtualMethod[SkeletonModifier3DProcessModificationVirtual]("_process_modification"),
+		NewEngineVirtualMethod[SkeletonModifier3DSkeletonChangedVirtual]("_skeleton_changed", "old_skeleton", "new_skeleton"),
+		NewEngineVirtualMethod[SkeletonModifier3DValidateBoneNamesVirtual]("_validate_bone_names"),
+	})
+	GDEngineVirtualMethods.Set("StreamPeerExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[StreamPeerExtensionGetDataVirtual]("_get_data", "r_buffer", "r_bytes", "r_received"),
+		NewEngineVirtualMethod[StreamPeerExtensionGetPartialDataVirtual]("_get_partial_data", "r_buffer", "r_bytes", "r_received"),
+		NewEngineVirtualMethod[StreamPeerExtensionPutDataVirtual]("_put_data", "p_data", "p_bytes", "r_sent"),
+		NewEngineVirtualMethod[StreamPeerExtensionPutPartialDataVirtual]("_put_partial_data", "p_data", "p_bytes", "r_sent"),
+		NewEngineVirtualMethod[StreamPeerExtensionGetAvailableBytesVirtual]("_get_available_bytes"),
+	})
+	GDEngineVirtualMethods.Set("StyleBox", []EngineVirtualMethod{
+		NewEngineVirtualMethod[StyleBoxDrawVirtual]("_draw", "to_canvas_item", "rect"),
+		NewEngineVirtualMethod[StyleBoxGetDrawRectVirtual]("_get_draw_rect", "rect"),
+		NewEngineVirtualMethod[StyleBoxGetMinimumSizeVirtual]("_get_minimum_size"),
+		NewEngineVirtualMethod[StyleBoxTestMaskVirtual]("_test_mask", "point", "rect"),
+	})
+	GDEngineVirtualMethods.Set("SubViewportContainer", []EngineVirtualMethod{
+		NewEngineVirtualMethod[SubViewportContainerPropagateInputEventVirtual]("_propagate_input_event", "event"),
+	})
+	GDEngineVirtualMethods.Set("SyntaxHighlighter", []EngineVirtualMethod{
+		NewEngineVirtualMethod[SyntaxHighlighterGetLineSyntaxHighlightingVirtual]("_get_line_syntax_highlighting", "line"),
+		NewEngineVirtualMethod[SyntaxHighlighterClearHighlightingCacheVirtual]("_clear_highlighting_cache"),
+		NewEngineVirtualMethod[SyntaxHighlighterUpdateCacheVirtual]("_update_cache"),
+	})
+	GDEngineVirtualMethods.Set("TextEdit", []EngineVirtualMethod{
+		NewEngineVirtualMethod[TextEditHandleUnicodeInputVirtual]("_handle_unicode_input", "unicode_char", "caret_index"),
+		NewEngineVirtualMethod[TextEditBackspaceVirtual]("_backspace", "caret_index"),
+		NewEngineVirtualMethod[TextEditCutVirtual]("_cut", "caret_index"),
+		NewEngineVirtualMethod[TextEditCopyVirtual]("_copy", "caret_index"),
+		NewEngineVirtualMethod[TextEditPasteVirtual]("_paste", "caret_index"),
+		NewEngineVirtualMethod[TextEditPastePrimaryClipboardVirtual]("_paste_primary_clipboard", "caret_index"),
+	})
+	GDEngineVirtualMethods.Set("TextServerExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[TextServerExtensionHasFeatureVirtual]("_has_feature", "feature"),
+		NewEngineVirtualMethod[TextServerExtensionGetNameVirtual]("_get_name"),
+		NewEngineVirtualMethod[TextServerExtensionGetFeaturesVirtual]("_get_features"),
+		NewEngineVirtualMethod[TextServerExtensionFreeRidVirtual]("_free_rid", "rid"),
+		NewEngineVirtualMethod[TextServerExtensionHasVirtual]("_has", "rid"),
+		NewEngineVirtualMethod[TextServerExtensionLoadSupportDataVirtual]("_load_support_data", "filename"),
+		NewEngineVirtualMethod[TextServerExtensionGetSupportDataFilenameVirtual]("_get_support_data_filename"),
+		NewEngineVirtualMethod[TextServerExtensionGetSupportDataInfoVirtual]("_get_support_data_info"),
+		NewEngineVirtualMethod[TextServerExtensionSaveSupportDataVirtual]("_save_support_data", "filename"),
+		NewEngineVirtualMethod[TextServerExtensionGetSupportDataVirtual]("_get_support_data"),
+		NewEngineVirtualMethod[TextServerExtensionIsLocaleRightToLeftVirtual]("_is_locale_right_to_left", "locale"),
+		NewEngineVirtualMethod[TextServerExtensionNameToTagVirtual]("_name_to_tag", "name"),
+		NewEngineVirtualMethod[TextServerExtensionTagToNameVirtual]("_tag_to_name", "tag"),
+		NewEngineVirtualMethod[TextServerExtensionCreateFontVirtual]("_create_font"),
+		NewEngineVirtualMethod[TextServerExtensionCreateFontLinkedVariationVirtual]("_create_font_linked_variation", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetDataVirtual]("_font_set_data", "font_rid", "data"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetDataPtrVirtual]("_font_set_data_ptr", "font_rid", "data_ptr", "data_size"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetFaceIndexVirtual]("_font_set_face_index", "font_rid", "face_index"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetFaceIndexVirtual]("_font_get_face_index", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetFaceCountVirtual]("_font_get_face_count", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetStyleVirtual]("_font_set_style", "font_rid", "style"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetStyleVirtual]("_font_get_style", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetNameVirtual]("_font_set_name", "font_rid", "name"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetNameVirtual]("_font_get_name", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetOtNameStringsVirtual]("_font_get_ot_name_strings", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetStyleNameVirtual]("_font_set_style_name", "font_rid", "name_style"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetStyleNameVirtual]("_font_get_style_name", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetWeightVirtual]("_font_set_weight", "font_rid", "weight"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetWeightVirtual]("_font_get_weight", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetStretchVirtual]("_font_set_stretch", "font_rid", "stretch"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetStretchVirtual]("_font_get_stretch", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetAntialiasingVirtual]("_font_set_antialiasing", "font_rid", "antialiasing"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetAntialiasingVirtual]("_font_get_antialiasing", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetDisableEmbeddedBitmapsVirtual]("_font_set_disable_embedded_bitmaps", "font_rid", "disable_embedded_bitmaps"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetDisableEmbeddedBitmapsVirtual]("_font_get_disable_embedded_bitmaps", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetGenerateMipmapsVirtual]("_font_set_generate_mipmaps", "font_rid", "generate_mipmaps"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetGenerateMipmapsVirtual]("_font_get_generate_mipmaps", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetMultichannelSignedDistanceFieldVirtual]("_font_set_multichannel_signed_distance_field", "font_rid", "msdf"),
+		NewEngineVirtualMethod[TextServerExtensionFontIsMultichannelSignedDistanceFieldVirtual]("_font_is_multichannel_signed_distance_field", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetMsdfPixelRangeVirtual]("_font_set_msdf_pixel_range", "font_rid", "msdf_pixel_range"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetMsdfPixelRangeVirtual]("_font_get_msdf_pixel_range", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetMsdfSizeVirtual]("_font_set_msdf_size", "font_rid", "msdf_size"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetMsdfSizeVirtual]("_font_get_msdf_size", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetFixedSizeVirtual]("_font_set_fixed_size", "font_rid", "fixed_size"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetFixedSizeVirtual]("_font_get_fixed_size", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetFixedSizeScaleModeVirtual]("_font_set_fixed_size_scale_mode", "font_rid", "fixed_size_scale_mode"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetFixedSizeScaleModeVirtual]("_font_get_fixed_size_scale_mode", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetAllowSystemFallbackVirtual]("_font_set_allow_system_fallback", "font_rid", "allow_system_fallback"),
+		NewEngineVirtualMethod[TextServerExtensionFontIsAllowSystemFallbackVirtual]("_font_is_allow_system_fallback", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontClearSystemFallbackCacheVirtual]("_font_clear_system_fallback_cache"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetForceAutohinterVirtual]("_font_set_force_autohinter", "font_rid", "force_autohinter"),
+		NewEngineVirtualMethod[TextServerExtensionFontIsForceAutohinterVirtual]("_font_is_force_autohinter", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetModulateColorGlyphsVirtual]("_font_set_modulate_color_glyphs", "font_rid", "modulate"),
+		NewEngineVirtualMethod[TextServerExtensionFontIsModulateColorGlyphsVirtual]("_font_is_modulate_color_glyphs", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetHintingVirtual]("_font_set_hinting", "font_rid", "hinting"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetHintingVirtual]("_font_get_hinting", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetSubpixelPositioningVirtual]("_font_set_subpixel_positioning", "font_rid", "subpixel_positioning"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetSubpixelPositioningVirtual]("_font_get_subpixel_positioning", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetKeepRoundingRemaindersVirtual]("_font_set_keep_rounding_remainders", "font_rid", "keep_rounding_remainders"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetKeepRoundingRemaindersVirtual]("_font_get_keep_rounding_remainders", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetEmboldenVirtual]("_font_set_embolden", "font_rid", "strength"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetEmboldenVirtual]("_font_get_embolden", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetSpacingVirtual]("_font_set_spacing", "font_rid", "spacing", "value"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetSpacingVirtual]("_font_get_spacing", "font_rid", "spacing"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetBaselineOffsetVirtual]("_font_set_baseline_offset", "font_rid", "baseline_offset"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetBaselineOffsetVirtual]("_font_get_baseline_offset", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetTransformVirtual]("_font_set_transform", "font_rid", "transform"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetTransformVirtual]("_font_get_transform", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetVariationCoordinatesVirtual]("_font_set_variation_coordinates", "font_rid", "variation_coordinates"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetVariationCoordinatesVirtual]("_font_get_variation_coordinates", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetOversamplingVirtual]("_font_set_oversampling", "font_rid", "oversampling"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetOversamplingVirtual]("_font_get_oversampling", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetSizeCacheListVirtual]("_font_get_size_cache_list", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontClearSizeCacheVirtual]("_font_clear_size_cache", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontRemoveSizeCacheVirtual]("_font_remove_size_cache", "font_rid", "size"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetSizeCacheInfoVirtual]("_font_get_size_cache_info", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetAscentVirtual]("_font_set_ascent", "font_rid", "size", "ascent"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetAscentVirtual]("_font_get_ascent", "font_rid", "size"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetDescentVirtual]("_font_set_descent", "font_rid", "size", "descent"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetDescentVirtual]("_font_get_descent", "font_rid", "size"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetUnderlinePositionVirtual]("_font_set_underline_position", "font_rid", "size", "underline_position"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetUnderlinePositionVirtual]("_font_get_underline_position", "font_rid", "size"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetUnderlineThicknessVirtual]("_font_set_underline_thickness", "font_rid", "size", "underline_thickness"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetUnderlineThicknessVirtual]("_font_get_underline_thickness", "font_rid", "size"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetScaleVirtual]("_font_set_scale", "font_rid", "size", "scale"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetScaleVirtual]("_font_get_scale", "font_rid", "size"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetTextureCountVirtual]("_font_get_texture_count", "font_rid", "size"),
+		NewEngineVirtualMethod[TextServerExtensionFontClearTexturesVirtual]("_font_clear_textures", "font_rid", "size"),
+		NewEngineVirtualMethod[TextServerExtensionFontRemoveTextureVirtual]("_font_remove_texture", "font_rid", "size", "texture_index"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetTextureImageVirtual]("_font_set_texture_image", "font_rid", "size", "texture_index", "image"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetTextureImageVirtual]("_font_get_texture_image", "font_rid", "size", "texture_index"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetTextureOffsetsVirtual]("_font_set_texture_offsets", "font_rid", "size", "texture_index", "offset"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetTextureOffsetsVirtual]("_font_get_texture_offsets", "font_rid", "size", "texture_index"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetGlyphListVirtual]("_font_get_glyph_list", "font_rid", "size"),
+		NewEngineVirtualMethod[TextServerExtensionFontClearGlyphsVirtual]("_font_clear_glyphs", "font_rid", "size"),
+		NewEngineVirtualMethod[TextServerExtensionFontRemoveGlyphVirtual]("_font_remove_glyph", "font_rid", "size", "glyph"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetGlyphAdvanceVirtual]("_font_get_glyph_advance", "font_rid", "size", "glyph"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetGlyphAdvanceVirtual]("_font_set_glyph_advance", "font_rid", "size", "glyph", "advance"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetGlyphOffsetVirtual]("_font_get_glyph_offset", "font_rid", "size", "glyph"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetGlyphOffsetVirtual]("_font_set_glyph_offset", "font_rid", "size", "glyph", "offset"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetGlyphSizeVirtual]("_font_get_glyph_size", "font_rid", "size", "glyph"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetGlyphSizeVirtual]("_font_set_glyph_size", "font_rid", "size", "glyph", "gl_size"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetGlyphUvRectVirtual]("_font_get_glyph_uv_rect", "font_rid", "size", "glyph"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetGlyphUvRectVirtual]("_font_set_glyph_uv_rect", "font_rid", "size", "glyph", "uv_rect"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetGlyphTextureIdxVirtual]("_font_get_glyph_texture_idx", "font_rid", "size", "glyph"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetGlyphTextureIdxVirtual]("_font_set_glyph_texture_idx", "font_rid", "size", "glyph", "texture_idx"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetGlyphTextureRidVirtual]("_font_get_glyph_texture_rid", "font_rid", "size", "glyph"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetGlyphTextureSizeVirtual]("_font_get_glyph_texture_size", "font_rid", "size", "glyph"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetGlyphContoursVirtual]("_font_get_glyph_contours", "font_rid", "size", "index"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetKerningListVirtual]("_font_get_kerning_list", "font_rid", "size"),
+		NewEngineVirtualMethod[TextServerExtensionFontClearKerningMapVirtual]("_font_clear_kerning_map", "font_rid", "size"),
+		NewEngineVirtualMethod[TextServerExtensionFontRemoveKerningVirtual]("_font_remove_kerning", "font_rid", "size", "glyph_pair"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetKerningVirtual]("_font_set_kerning", "font_rid", "size", "glyph_pair", "kerning"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetKerningVirtual]("_font_get_kerning", "font_rid", "size", "glyph_pair"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetGlyphIndexVirtual]("_font_get_glyph_index", "font_rid", "size", "char", "variation_selector"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetCharFromGlyphIndexVirtual]("_font_get_char_from_glyph_index", "font_rid", "size", "glyph_index"),
+		NewEngineVirtualMethod[TextServerExtensionFontHasCharVirtual]("_font_has_char", "font_rid", "char"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetSupportedCharsVirtual]("_font_get_supported_chars", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetSupportedGlyphsVirtual]("_font_get_supported_glyphs", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontRenderRangeVirtual]("_font_render_range", "font_rid", "size", "start", "end"),
+		NewEngineVirtualMethod[TextServerExtensionFontRenderGlyphVirtual]("_font_render_glyph", "font_rid", "size", "index"),
+		NewEngineVirtualMethod[TextServerExtensionFontDrawGlyphVirtual]("_font_draw_glyph", "font_rid", "canvas", "size", "pos", "index", "color", "oversampling"),
+		NewEngineVirtualMethod[TextServerExtensionFontDrawGlyphOutlineVirtual]("_font_draw_glyph_outline", "font_rid", "canvas", "size", "outline_size", "pos", "index", "color", "oversampling"),
+		NewEngineVirtualMethod[TextServerExtensionFontIsLanguageSupportedVirtual]("_font_is_language_supported", "font_rid", "language"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetLanguageSupportOverrideVirtual]("_font_set_language_support_override", "font_rid", "language", "supported"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetLanguageSupportOverrideVirtual]("_font_get_language_support_override", "font_rid", "language"),
+		NewEngineVirtualMethod[TextServerExtensionFontRemoveLanguageSupportOverrideVirtual]("_font_remove_language_support_override", "font_rid", "language"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetLanguageSupportOverridesVirtual]("_font_get_language_support_overrides", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontIsScriptSupportedVirtual]("_font_is_script_supported", "font_rid", "script"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetScriptSupportOverrideVirtual]("_font_set_script_support_override", "font_rid", "script", "supported"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetScriptSupportOverrideVirtual]("_font_get_script_support_override", "font_rid", "script"),
+		NewEngineVirtualMethod[TextServerExtensionFontRemoveScriptSupportOverrideVirtual]("_font_remove_script_support_override", "font_rid", "script"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetScriptSupportOverridesVirtual]("_font_get_script_support_overrides", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetOpentypeFeatureOverridesVirtual]("_font_set_opentype_feature_overrides", "font_rid", "overrides"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetOpentypeFeatureOverridesVirtual]("_font_get_opentype_feature_overrides", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSupportedFeatureListVirtual]("_font_supported_feature_list", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontSupportedVariationListVirtual]("_font_supported_variation_list", "font_rid"),
+		NewEngineVirtualMethod[TextServerExtensionFontGetGlobalOversamplingVirtual]("_font_get_global_oversampling"),
+		NewEngineVirtualMethod[TextServerExtensionFontSetGlobalOversamplingVirtual]("_font_set_global_oversampling", "oversampling"),
+		NewEngineVirtualMethod[TextServerExtensionReferenceOversamplingLevelVirtual]("_reference_oversampling_level", "oversampling"),
+		NewEngineVirtualMethod[TextServerExtensionUnreferenceOversamplingLevelVirtual]("_unreference_oversampling_level", "oversampling"),
+		NewEngineVirtualMethod[TextServerExtensionGetHexCodeBoxSizeVirtual]("_get_hex_code_box_size", "size", "index"),
+		NewEngineVirtualMethod[TextServerExtensionDrawHexCodeBoxVirtual]("_draw_hex_code_box", "canvas", "size", "pos", "index", "color"),
+		NewEngineVirtualMethod[TextServerExtensionCreateShapedTextVirtual]("_create_shaped_text", "direction", "orientation"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextClearVirtual]("_shaped_text_clear", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextSetDirectionVirtual]("_shaped_text_set_direction", "shaped", "direction"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetDirectionVirtual]("_shaped_text_get_direction", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetInferredDirectionVirtual]("_shaped_text_get_inferred_direction", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextSetBidiOverrideVirtual]("_shaped_text_set_bidi_override", "shaped", "override"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextSetCustomPunctuationVirtual]("_shaped_text_set_custom_punctuation", "shaped", "punct"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetCustomPunctuationVirtual]("_shaped_text_get_custom_punctuation", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextSetCustomEllipsisVirtual]("_shaped_text_set_custom_ellipsis", "shaped", "char"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetCustomEllipsisVirtual]("_shaped_text_get_custom_ellipsis", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextSetOrientationVirtual]("_shaped_text_set_orientation", "shaped", "orientation"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetOrientationVirtual]("_shaped_text_get_orientation", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextSetPreserveInvalidVirtual]("_shaped_text_set_preserve_invalid", "shaped", "enabled"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetPreserveInvalidVirtual]("_shaped_text_get_preserve_invalid", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextSetPreserveControlVirtual]("_shaped_text_set_preserve_control", "shaped", "enabled"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetPreserveControlVirtual]("_shaped_text_get_preserve_control", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextSetSpacingVirtual]("_shaped_text_set_spacing", "shaped", "spacing", "value"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetSpacingVirtual]("_shaped_text_get_spacing", "shaped", "spacing"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextAddStringVirtual]("_shaped_text_add_string", "shaped", "text", "fonts", "size", "opentype_features", "language", "meta"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextAddObjectVirtual]("_shaped_text_add_object", "shaped", "key", "size", "inline_align", "length", "baseline"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextResizeObjectVirtual]("_shaped_text_resize_object", "shaped", "key", "size", "inline_align", "baseline"),
+		NewEngineVirtualMethod[TextServerExtensionShapedGetTextVirtual]("_shaped_get_text", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedGetSpanCountVirtual]("_shaped_get_span_count", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedGetSpanMetaVirtual]("_shaped_get_span_meta", "shaped", "index"),
+		NewEngineVirtualMethod[TextServerExtensionShapedGetSpanEmbeddedObjectVirtual]("_shaped_get_span_embedded_object", "shaped", "index"),
+		NewEngineVirtualMethod[TextServerExtensionShapedGetSpanTextVirtual]("_shaped_get_span_text", "shaped", "index"),
+		NewEngineVirtualMethod[TextServerExtensionShapedGetSpanObjectVirtual]("_shaped_get_span_object", "shaped", "index"),
+		NewEngineVirtualMethod[TextServerExtensionShapedSetSpanUpdateFontVirtual]("_shaped_set_span_update_font", "shaped", "index", "fonts", "size", "opentype_features"),
+		NewEngineVirtualMethod[TextServerExtensionShapedGetRunCountVirtual]("_shaped_get_run_count", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedGetRunTextVirtual]("_shaped_get_run_text", "shaped", "index"),
+		NewEngineVirtualMethod[TextServerExtensionShapedGetRunRangeVirtual]("_shaped_get_run_range", "shaped", "index"),
+		NewEngineVirtualMethod[TextServerExtensionShapedGetRunFontRidVirtual]("_shaped_get_run_font_rid", "shaped", "index"),
+		NewEngineVirtualMethod[TextServerExtensionShapedGetRunFontSizeVirtual]("_shaped_get_run_font_size", "shaped", "index"),
+		NewEngineVirtualMethod[TextServerExtensionShapedGetRunLanguageVirtual]("_shaped_get_run_language", "shaped", "index"),
+		NewEngineVirtualMethod[TextServerExtensionShapedGetRunDirectionVirtual]("_shaped_get_run_direction", "shaped", "index"),
+		NewEngineVirtualMethod[TextServerExtensionShapedGetRunObjectVirtual]("_shaped_get_run_object", "shaped", "index"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextSubstrVirtual]("_shaped_text_substr", "shaped", "start", "length"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetParentVirtual]("_shaped_text_get_parent", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextFitToWidthVirtual]("_shaped_text_fit_to_width", "shaped", "width", "justification_flags"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextTabAlignVirtual]("_shaped_text_tab_align", "shaped", "tab_stops"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextShapeVirtual]("_shaped_text_shape", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextUpdateBreaksVirtual]("_shaped_text_update_breaks", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextUpdateJustificationOpsVirtual]("_shaped_text_update_justification_ops", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextIsReadyVirtual]("_shaped_text_is_ready", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetGlyphsVirtual]("_shaped_text_get_glyphs", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextSortLogicalVirtual]("_shaped_text_sort_logical", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetGlyphCountVirtual]("_shaped_text_get_glyph_count", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetRangeVirtual]("_shaped_text_get_range", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetLineBreaksAdvVirtual]("_shaped_text_get_line_breaks_adv", "shaped", "width", "start", "once", "break_flags"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetLineBreaksVirtual]("_shaped_text_get_line_breaks", "shaped", "width", "start", "break_flags"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetWordBreaksVirtual]("_shaped_text_get_word_breaks", "shaped", "grapheme_flags", "skip_grapheme_flags"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetTrimPosVirtual]("_shaped_text_get_trim_pos", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetEllipsisPosVirtual]("_shaped_text_get_ellipsis_pos", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetEllipsisGlyphCountVirtual]("_shaped_text_get_ellipsis_glyph_count", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetEllipsisGlyphsVirtual]("_shaped_text_get_ellipsis_glyphs", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextOverrunTrimToWidthVirtual]("_shaped_text_overrun_trim_to_width", "shaped", "width", "trim_flags"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetObjectsVirtual]("_shaped_text_get_objects", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetObjectRectVirtual]("_shaped_text_get_object_rect", "shaped", "key"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetObjectRangeVirtual]("_shaped_text_get_object_range", "shaped", "key"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetObjectGlyphVirtual]("_shaped_text_get_object_glyph", "shaped", "key"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetSizeVirtual]("_shaped_text_get_size", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetAscentVirtual]("_shaped_text_get_ascent", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetDescentVirtual]("_shaped_text_get_descent", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetWidthVirtual]("_shaped_text_get_width", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetUnderlinePositionVirtual]("_shaped_text_get_underline_position", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetUnderlineThicknessVirtual]("_shaped_text_get_underline_thickness", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetDominantDirectionInRangeVirtual]("_shaped_text_get_dominant_direction_in_range", "shaped", "start", "end"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetCaretsVirtual]("_shaped_text_get_carets", "shaped", "position", "caret"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetSelectionVirtual]("_shaped_text_get_selection", "shaped", "start", "end"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextHitTestGraphemeVirtual]("_shaped_text_hit_test_grapheme", "shaped", "coord"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextHitTestPositionVirtual]("_shaped_text_hit_test_position", "shaped", "coord"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextDrawVirtual]("_shaped_text_draw", "shaped", "canvas", "pos", "clip_l", "clip_r", "color", "oversampling"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextDrawOutlineVirtual]("_shaped_text_draw_outline", "shaped", "canvas", "pos", "clip_l", "clip_r", "outline_size", "color", "oversampling"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetGraphemeBoundsVirtual]("_shaped_text_get_grapheme_bounds", "shaped", "pos"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextNextGraphemePosVirtual]("_shaped_text_next_grapheme_pos", "shaped", "pos"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextPrevGraphemePosVirtual]("_shaped_text_prev_grapheme_pos", "shaped", "pos"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextGetCharacterBreaksVirtual]("_shaped_text_get_character_breaks", "shaped"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextNextCharacterPosVirtual]("_shaped_text_next_character_pos", "shaped", "pos"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextPrevCharacterPosVirtual]("_shaped_text_prev_character_pos", "shaped", "pos"),
+		NewEngineVirtualMethod[TextServerExtensionShapedTextClosestCharacterPosVirtual]("_shaped_text_closest_character_pos", "shaped", "pos"),
+		NewEngineVirtualMethod[TextServerExtensionFormatNumberVirtual]("_format_number", "number", "language"),
+		NewEngineVirtualMethod[TextServerExtensionParseNumberVirtual]("_parse_number", "number", "language"),
+		NewEngineVirtualMethod[TextServerExtensionPercentSignVirtual]("_percent_sign", "language"),
+		NewEngineVirtualMethod[TextServerExtensionStripDiacriticsVirtual]("_strip_diacritics", "string"),
+		NewEngineVirtualMethod[TextServerExtensionIsValidIdentifierVirtual]("_is_valid_identifier", "string"),
+		NewEngineVirtualMethod[TextServerExtensionIsValidLetterVirtual]("_is_valid_letter", "unicode"),
+		NewEngineVirtualMethod[TextServerExtensionStringGetWordBreaksVirtual]("_string_get_word_breaks", "string", "language", "chars_per_line"),
+		NewEngineVirtualMethod[TextServerExtensionStringGetCharacterBreaksVirtual]("_string_get_character_breaks", "string", "language"),
+		NewEngineVirtualMethod[TextServerExtensionIsConfusableVirtual]("_is_confusable", "string", "dict"),
+		NewEngineVirtualMethod[TextServerExtensionSpoofCheckVirtual]("_spoof_check", "string"),
+		NewEngineVirtualMethod[TextServerExtensionStringToUpperVirtual]("_string_to_upper", "string", "language"),
+		NewEngineVirtualMethod[TextServerExtensionStringToLowerVirtual]("_string_to_lower", "string", "language"),
+		NewEngineVirtualMethod[TextServerExtensionStringToTitleVirtual]("_string_to_title", "string", "language"),
+		NewEngineVirtualMethod[TextServerExtensionParseStructuredTextVirtual]("_parse_structured_text", "parser_type", "args", "text"),
+		NewEngineVirtualMethod[TextServerExtensionCleanupVirtual]("_cleanup"),
+	})
+	GDEngineVirtualMethods.Set("Texture2D", []EngineVirtualMethod{
+		NewEngineVirtualMethod[Texture2DGetWidthVirtual]("_get_width"),
+		NewEngineVirtualMethod[Texture2DGetHeightVirtual]("_get_height"),
+		NewEngineVirtualMethod[Texture2DIsPixelOpaqueVirtual]("_is_pixel_opaque", "x", "y"),
+		NewEngineVirtualMethod[Texture2DHasAlphaVirtual]("_has_alpha"),
+		NewEngineVirtualMethod[Texture2DDrawVirtual]("_draw", "to_canvas_item", "pos", "modulate", "transpose"),
+		NewEngineVirtualMethod[Texture2DDrawRectVirtual]("_draw_rect", "to_canvas_item", "rect", "tile", "modulate", "transpose"),
+		NewEngineVirtualMethod[Texture2DDrawRectRegionVirtual]("_draw_rect_region", "to_canvas_item", "rect", "src_rect", "modulate", "transpose", "clip_uv"),
+	})
+	GDEngineVirtualMethods.Set("Texture3D", []EngineVirtualMethod{
+		NewEngineVirtualMethod[Texture3DGetFormatVirtual]("_get_format"),
+		NewEngineVirtualMethod[Texture3DGetWidthVirtual]("_get_width"),
+		NewEngineVirtualMethod[Texture3DGetHeightVirtual]("_get_height"),
+		NewEngineVirtualMethod[Texture3DGetDepthVirtual]("_get_depth"),
+		NewEngineVirtualMethod[Texture3DHasMipmapsVirtual]("_has_mipmaps"),
+		NewEngineVirtualMethod[Texture3DGetDataVirtual]("_get_data"),
+	})
+	GDEngineVirtualMethods.Set("TextureLayered", []EngineVirtualMethod{
+		NewEngineVirtualMethod[TextureLayeredGetFormatVirtual]("_get_format"),
+		NewEngineVirtualMethod[TextureLayeredGetLayeredTypeVirtual]("_get_layered_type"),
+		NewEngineVirtualMethod[TextureLayeredGetWidthVirtual]("_get_width"),
+		NewEngineVirtualMethod[TextureLayeredGetHeightVirtual]("_get_height"),
+		NewEngineVirtualMethod[TextureLayeredGetLayersVirtual]("_get_layers"),
+		NewEngineVirtualMethod[TextureLayeredHasMipmapsVirtual]("_has_mipmaps"),
+		NewEngineVirtualMethod[TextureLayeredGetLayerDataVirtual]("_get_layer_data", "layer_index"),
+	})
+	GDEngineVirtualMethods.Set("TileMap", []EngineVirtualMethod{
+		NewEngineVirtualMethod[TileMapUseTileDataRuntimeUpdateVirtual]("_use_tile_data_runtime_update", "layer", "coords"),
+		NewEngineVirtualMethod[TileMapTileDataRuntimeUpdateVirtual]("_tile_data_runtime_update", "layer", "coords", "tile_data"),
+	})
+	GDEngineVirtualMethods.Set("TileMapLayer", []EngineVirtualMethod{
+		NewEngineVirtualMethod[TileMapLayerUseTileDataRuntimeUpdateVirtual]("_use_tile_data_runtime_update", "coords"),
+		NewEngineVirtualMethod[TileMapLayerTileDataRuntimeUpdateVirtual]("_tile_data_runtime_update", "coords", "tile_data"),
+		NewEngineVirtualMethod[TileMapLayerUpdateCellsVirtual]("_update_cells", "coords", "forced_cleanup"),
+	})
+	GDEngineVirtualMethods.Set("Translation", []EngineVirtualMethod{
+		NewEngineVirtualMethod[TranslationGetPluralMessageVirtual]("_get_plural_message", "src_message", "src_plural_message", "n", "context"),
+		NewEngineVirtualMethod[TranslationGetMessageVirtual]("_get_message", "src_message", "context"),
+	})
+	GDEngineVirtualMethods.Set("VideoStream", []EngineVirtualMethod{
+		NewEngineVirtualMethod[VideoStreamInstantiatePlaybackVirtual]("_instantiate_playback"),
+	})
+	GDEngineVirtualMethods.Set("VideoStreamPlayback", []EngineVirtualMethod{
+		NewEngineVirtualMethod[VideoStreamPlaybackStopVirtual]("_stop"),
+		NewEngineVirtualMethod[VideoStreamPlaybackPlayVirtual]("_play"),
+		NewEngineVirtualMethod[VideoStreamPlaybackIsPlayingVirtual]("_is_playing"),
+		NewEngineVirtualMethod[VideoStreamPlaybackSetPausedVirtual]("_set_paused", "paused"),
+		NewEngineVirtualMethod[VideoStreamPlaybackIsPausedVirtual]("_is_paused"),
+		NewEngineVirtualMethod[VideoStreamPlaybackGetLengthVirtual]("_get_length"),
+		NewEngineVirtualMethod[VideoStreamPlaybackGetPlaybackPositionVirtual]("_get_playback_position"),
+		NewEngineVirtualMethod[VideoStreamPlaybackSeekVirtual]("_seek", "time"),
+		NewEngineVirtualMethod[VideoStreamPlaybackSetAudioTrackVirtual]("_set_audio_track", "idx"),
+		NewEngineVirtualMethod[VideoStreamPlaybackGetTextureVirtual]("_get_texture"),
+		NewEngineVirtualMethod[VideoStreamPlaybackUpdateVirtual]("_update", "delta"),
+		NewEngineVirtualMethod[VideoStreamPlaybackGetChannelsVirtual]("_get_channels"),
+		NewEngineVirtualMethod[VideoStreamPlaybackGetMixRateVirtual]("_get_mix_rate"),
+	})
+	GDEngineVirtualMethods.Set("VisualInstance3D", []EngineVirtualMethod{
+		NewEngineVirtualMethod[VisualInstance3DGetAabbVirtual]("_get_aabb"),
+	})
+	GDEngineVirtualMethods.Set("VisualShaderNodeCustom", []EngineVirtualMethod{
+		NewEngineVirtualMethod[VisualShaderNodeCustomGetNameVirtual]("_get_name"),
+		NewEngineVirtualMethod[VisualShaderNodeCustomGetDescriptionVirtual]("_get_description"),
+		NewEngineVirtualMethod[VisualShaderNodeCustomGetCategoryVirtual]("_get_category"),
+		NewEngineVirtualMethod[VisualShaderNodeCustomGetReturnIconTypeVirtual]("_get_return_icon_type"),
+		NewEngineVirtualMethod[VisualShaderNodeCustomGetInputPortCountVirtual]("_get_input_port_count"),
+		NewEngineVirtualMethod[VisualShaderNodeCustomGetInputPortTypeVirtual]("_get_input_port_type", "port"),
+		NewEngineVirtualMethod[VisualShaderNodeCustomGetInputPortNameVirtual]("_get_input_port_name", "port"),
+		NewEngineVirtualMethod[VisualShaderNodeCustomGetInputPortDefaultValueVirtual]("_get_input_port_default_value", "port"),
+		NewEngineVirtualMethod[VisualShaderNodeCustomGetDefaultInputPortVirtual]("_get_default_input_port", "type"),
+		NewEngineVirtualMethod[VisualShaderNodeCustomGetOutputPortCountVirtual]("_get_output_port_count"),
+		NewEngineVirtualMethod[VisualShaderNodeCustomGetOutputPortTypeVirtual]("_get_output_port_type", "port"),
+		NewEngineVirtualMethod[VisualShaderNodeCustomGetOutputPortNameVirtual]("_get_output_port_name", "port"),
+		NewEngineVirtualMethod[VisualShaderNodeCustomGetPropertyCountVirtual]("_get_property_count"),
+		NewEngineVirtualMethod[VisualShaderNodeCustomGetPropertyNameVirtual]("_get_property_name", "index"),
+		NewEngineVirtualMethod[VisualShaderNodeCustomGetPropertyDefaultIndexVirtual]("_get_property_default_index", "index"),
+		NewEngineVirtualMethod[VisualShaderNodeCustomGetPropertyOptionsVirtual]("_get_property_options", "index"),
+		NewEngineVirtualMethod[VisualShaderNodeCustomGetCodeVirtual]("_get_code", "input_vars", "output_vars", "mode", "type"),
+		NewEngineVirtualMethod[VisualShaderNodeCustomGetFuncCodeVirtual]("_get_func_code", "mode", "type"),
+		NewEngineVirtualMethod[VisualShaderNodeCustomGetGlobalCodeVirtual]("_get_global_code", "mode"),
+		NewEngineVirtualMethod[VisualShaderNodeCustomIsHighendVirtual]("_is_highend"),
+		NewEngineVirtualMethod[VisualShaderNodeCustomIsAvailableVirtual]("_is_available", "mode", "type"),
+	})
+	GDEngineVirtualMethods.Set("WebRTCDataChannelExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[WebRTCDataChannelExtensionGetPacketVirtual]("_get_packet", "r_buffer", "r_buffer_size"),
+		NewEngineVirtualMethod[WebRTCDataChannelExtensionPutPacketVirtual]("_put_packet", "p_buffer", "p_buffer_size"),
+		NewEngineVirtualMethod[WebRTCDataChannelExtensionGetAvailablePacketCountVirtual]("_get_available_packet_count"),
+		NewEngineVirtualMethod[WebRTCDataChannelExtensionGetMaxPacketSizeVirtual]("_get_max_packet_size"),
+		NewEngineVirtualMethod[WebRTCDataChannelExtensionPollVirtual]("_poll"),
+		NewEngineVirtualMethod[WebRTCDataChannelExtensionCloseVirtual]("_close"),
+		NewEngineVirtualMethod[WebRTCDataChannelExtensionSetWriteModeVirtual]("_set_write_mode", "p_write_mode"),
+		NewEngineVirtualMethod[WebRTCDataChannelExtensionGetWriteModeVirtual]("_get_write_mode"),
+		NewEngineVirtualMethod[WebRTCDataChannelExtensionWasStringPacketVirtual]("_was_string_packet"),
+		NewEngineVirtualMethod[WebRTCDataChannelExtensionGetReadyStateVirtual]("_get_ready_state"),
+		NewEngineVirtualMethod[WebRTCDataChannelExtensionGetLabelVirtual]("_get_label"),
+		NewEngineVirtualMethod[WebRTCDataChannelExtensionIsOrderedVirtual]("_is_ordered"),
+		NewEngineVirtualMethod[WebRTCDataChannelExtensionGetIdVirtual]("_get_id"),
+		NewEngineVirtualMethod[WebRTCDataChannelExtensionGetMaxPacketLifeTimeVirtual]("_get_max_packet_life_time"),
+		NewEngineVirtualMethod[WebRTCDataChannelExtensionGetMaxRetransmitsVirtual]("_get_max_retransmits"),
+		NewEngineVirtualMethod[WebRTCDataChannelExtensionGetProtocolVirtual]("_get_protocol"),
+		NewEngineVirtualMethod[WebRTCDataChannelExtensionIsNegotiatedVirtual]("_is_negotiated"),
+		NewEngineVirtualMethod[WebRTCDataChannelExtensionGetBufferedAmountVirtual]("_get_buffered_amount"),
+	})
+	GDEngineVirtualMethods.Set("WebRTCPeerConnectionExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[WebRTCPeerConnectionExtensionGetConnectionStateVirtual]("_get_connection_state"),
+		NewEngineVirtualMethod[WebRTCPeerConnectionExtensionGetGatheringStateVirtual]("_get_gathering_state"),
+		NewEngineVirtualMethod[WebRTCPeerConnectionExtensionGetSignalingStateVirtual]("_get_signaling_state"),
+		NewEngineVirtualMethod[WebRTCPeerConnectionExtensionInitializeVirtual]("_initialize", "p_config"),
+		NewEngineVirtualMethod[WebRTCPeerConnectionExtensionCreateDataChannelVirtual]("_create_data_channel", "p_label", "p_config"),
+		NewEngineVirtualMethod[WebRTCPeerConnectionExtensionCreateOfferVirtual]("_create_offer"),
+		NewEngineVirtualMethod[WebRTCPeerConnectionExtensionSetRemoteDescriptionVirtual]("_set_remote_description", "p_type", "p_sdp"),
+		NewEngineVirtualMethod[WebRTCPeerConnectionExtensionSetLocalDescriptionVirtual]("_set_local_description", "p_type", "p_sdp"),
+		NewEngineVirtualMethod[WebRTCPeerConnectionExtensionAddIceCandidateVirtual]("_add_ice_candidate", "p_sdp_mid_name", "p_sdp_mline_index", "p_sdp_name"),
+		NewEngineVirtualMethod[WebRTCPeerConnectionExtensionPollVirtual]("_poll"),
+		NewEngineVirtualMethod[WebRTCPeerConnectionExtensionCloseVirtual]("_close"),
+	})
+	GDEngineVirtualMethods.Set("Window", []EngineVirtualMethod{
+		NewEngineVirtualMethod[WindowGetContentsMinimumSizeVirtual]("_get_contents_minimum_size"),
+	})
+	GDEngineVirtualMethods.Set("XRInterfaceExtension", []EngineVirtualMethod{
+		NewEngineVirtualMethod[XRInterfaceExtensionGetNameVirtual]("_get_name"),
+		NewEngineVirtualMethod[XRInterfaceExtensionGetCapabilitiesVirtual]("_get_capabilities"),
+		NewEngineVirtualMethod[XRInterfaceExtensionIsInitializedVirtual]("_is_initialized"),
+		NewEngineVirtualMethod[XRInterfaceExtensionInitializeVirtual]("_initialize"),
+		NewEngineVirtualMethod[XRInterfaceExtensionUninitializeVirtual]("_uninitialize"),
+		NewEngineVirtualMethod[XRInterfaceExtensionGetSystemInfoVirtual]("_get_system_info"),
+		NewEngineVirtualMethod[XRInterfaceExtensionSupportsPlayAreaModeVirtual]("_supports_play_area_mode", "mode"),
+		NewEngineVirtualMethod[XRInterfaceExtensionGetPlayAreaModeVirtual]("_get_play_area_mode"),
+		NewEngineVirtualMethod[XRInterfaceExtensionSetPlayAreaModeVirtual]("_set_play_area_mode", "mode"),
+		NewEngineVirtualMethod[XRInterfaceExtensionGetPlayAreaVirtual]("_get_play_area"),
+		NewEngineVirtualMethod[XRInterfaceExtensionGetRenderTargetSizeVirtual]("_get_render_target_size"),
+		NewEngineVirtualMethod[XRInterfaceExtensionGetViewCountVirtual]("_get_view_count"),
+		NewEngineVirtualMethod[XRInterfaceExtensionGetCameraTransformVirtual]("_get_camera_transform"),
+		NewEngineVirtualMethod[XRInterfaceExtensionGetTransformForViewVirtual]("_get_transform_for_view", "view", "cam_transform"),
+		NewEngineVirtualMethod[XRInterfaceExtensionGetProjectionForViewVirtual]("_get_projection_for_view", "view", "aspect", "z_near", "z_far"),
+		NewEngineVirtualMethod[XRInterfaceExtensionGetVrsTextureVirtual]("_get_vrs_texture"),
+		NewEngineVirtualMethod[XRInterfaceExtensionGetVrsTextureFormatVirtual]("_get_vrs_texture_format"),
+		NewEngineVirtualMethod[XRInterfaceExtensionProcessVirtual]("_process"),
+		NewEngineVirtualMethod[XRInterfaceExtensionPreRenderVirtual]("_pre_render"),
+		NewEngineVirtualMethod[XRInterfaceExtensionPreDrawViewportVirtual]("_pre_draw_viewport", "render_target"),
+		NewEngineVirtualMethod[XRInterfaceExtensionPostDrawViewportVirtual]("_post_draw_viewport", "render_target", "screen_rect"),
+		NewEngineVirtualMethod[XRInterfaceExtensionEndFrameVirtual]("_end_frame"),
+		NewEngineVirtualMethod[XRInterfaceExtensionGetSuggestedTrackerNamesVirtual]("_get_suggested_tracker_names"),
+		NewEngineVirtualMethod[XRInterfaceExtensionGetSuggestedPoseNamesVirtual]("_get_suggested_pose_names", "tracker_name"),
+		NewEngineVirtualMethod[XRInterfaceExtensionGetTrackingStatusVirtual]("_get_tracking_status"),
+		NewEngineVirtualMethod[XRInterfaceExtensionTriggerHapticPulseVirtual]("_trigger_haptic_pulse", "action_name", "tracker_name", "frequency", "amplitude", "duration_sec", "delay_sec"),
+		NewEngineVirtualMethod[XRInterfaceExtensionGetAnchorDetectionIsEnabledVirtual]("_get_anchor_detection_is_enabled"),
+		NewEngineVirtualMethod[XRInterfaceExtensionSetAnchorDetectionIsEnabledVirtual]("_set_anchor_detection_is_enabled", "enabled"),
+		NewEngineVirtualMethod[XRInterfaceExtensionGetCameraFeedIdVirtual]("_get_camera_feed_id"),
+		NewEngineVirtualMethod[XRInterfaceExtensionGetColorTextureVirtual]("_get_color_texture"),
+		NewEngineVirtualMethod[XRInterfaceExtensionGetDepthTextureVirtual]("_get_depth_texture"),
+		NewEngineVirtualMethod[XRInterfaceExtensionGetVelocityTextureVirtual]("_get_velocity_texture"),
+	})
 }
 
 func RegisterEngineClassRefs() {
@@ -0,0 +1,30 @@
+package gdclassinit
+
+import (
+	"reflect"
+)
+
+// EngineVirtualMethod is a virtual method declared by an engine class. Go
+// classes override it by implementing the single method interface Type,
+// such as NodeProcessVirtual for Node._process.
+type EngineVirtualMethod struct {
+	Name     string
+	ArgNames []string
+	Type     reflect.Type
+}
+
+// NewEngineVirtualMethod describes the virtual method name overridden
+// through the interface T.
+func NewEngineVirtualMethod[T any](name string, argNames ...string) EngineVirtualMethod {
+	return EngineVirtualMethod{
+		Name:     name,
+		ArgNames: argNames,
+		Type:     reflect.TypeFor[T](),
+	}
+}
+
+// GoMethodName returns the name of the "V_" method implementing the
+// virtual method.
+func (m EngineVirtualMethod) GoMethodName() string {
+	return m.Type.Method(0).Name
+}
@@ -18,5 +18,6 @@ var (
 	GDNativeConstructors        = NewSyncMap[string, GDExtensionClassGoConstructorFromOwner]()
 	GDClassRefConstructors      = NewSyncMap[string, RefCountedConstructor]()
 	GDRegisteredGDClassEncoders = NewSyncMap[string, ArgumentEncoder]()
+	GDEngineVirtualMethods      = NewSyncMap[string, []EngineVirtualMethod]()
 	pnr                         runtime.Pinner
 )
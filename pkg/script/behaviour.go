@@ -0,0 +1,280 @@
+package script
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/constant"
+	. "github.com/godot-go/godot-go/pkg/core"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	. "github.com/godot-go/godot-go/pkg/util"
+	"go.uber.org/zap"
+)
+
+// Behaviour is implemented by the Go values a GoScript attaches to an object.
+// Behaviours embed BehaviourImpl, which implements it.
+type Behaviour interface {
+	// Owner returns the object the behaviour is attached to.
+	Owner() Object
+	attach(owner Object)
+}
+
+// BehaviourImpl is embedded by every behaviour.
+type BehaviourImpl struct {
+	owner Object
+}
+
+func (b *BehaviourImpl) Owner() Object {
+	return b.owner
+}
+
+func (b *BehaviourImpl) attach(owner Object) {
+	b.owner = owner
+}
+
+// behaviourInfo describes a registered behaviour type.
+type behaviourInfo struct {
+	name         string
+	baseType     string
+	newValue     func() Behaviour
+	properties   []behaviourProperty
+	propertyByGd map[string]int
+	methods      []behaviourMethod
+	methodByGd   map[string]int
+	notification bool
+}
+
+// behaviourProperty is a field of a behaviour tagged `godot:"property"`.
+type behaviourProperty struct {
+	name        string
+	fieldIndex  []int
+	fieldType   reflect.Type
+	variantType GDExtensionVariantType
+	options     PropertyOptions
+}
+
+// behaviourMethod is an exported method of a behaviour.
+type behaviourMethod struct {
+	gdName     string
+	goName     string
+	argTypes   []GDExtensionVariantType
+	returnType GDExtensionVariantType
+	hasReturn  bool
+	variadic   bool
+}
+
+var behaviours = NewSyncMap[string, *behaviourInfo]()
+
+// RegisterBehaviour makes the behaviour returned by newBehaviour available to
+// GoScripts under name. baseType is the class a GoScript with this behaviour
+// can be attached to, e.g. "Node2D".
+//
+// Exported methods of T are callable from GDScript with a snake_case name
+// (Jump becomes jump); methods prefixed with "V_" override engine virtual
+// methods (V_Process becomes _process). V_Notification, declared as
+// func(what int32, reversed bool), receives the notifications of the owner.
+// Fields tagged `godot:"property,..."` are exposed as script properties;
+// the tag supports the name, hint, hint_string, usage and class_name options
+// of ClassDBRegisterClassAuto. The values newBehaviour initializes the fields
+// with are reported as the property defaults.
+//
+// Every problem found with T is returned in a single error and nothing is
+// registered.
+func RegisterBehaviour[T Behaviour](name, baseType string, newBehaviour func() T) error {
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Pointer || t.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("unable to register behaviour %s: expected a pointer to a struct, not %s", name, t)
+	}
+	if behaviours.HasKey(name) {
+		return fmt.Errorf("unable to register behaviour %s: name already registered", name)
+	}
+	info, errs := newBehaviourInfo(t, name, baseType)
+	if len(errs) > 0 {
+		return fmt.Errorf("unable to register behaviour %s:\n%w", name, errors.Join(errs...))
+	}
+	info.newValue = func() Behaviour {
+		return newBehaviour()
+	}
+	behaviours.Set(name, info)
+	log.Debug("behaviour registered",
+		zap.String("name", name),
+		zap.String("base_type", baseType),
+		zap.Int("methods", len(info.methods)),
+		zap.Int("properties", len(info.properties)),
+	)
+	return nil
+}
+
+// UnregisterBehaviour removes a behaviour registered with RegisterBehaviour.
+// Scripts already instantiated keep their behaviour.
+func UnregisterBehaviour(name string) {
+	behaviours.Delete(name)
+}
+
+// BehaviourNames returns the names of the registered behaviours in sorted
+// order.
+func BehaviourNames() []string {
+	names := behaviours.Keys()
+	slices.Sort(names)
+	return names
+}
+
+func newBehaviourInfo(t reflect.Type, name, baseType string) (*behaviourInfo, []error) {
+	var (
+		errs []error
+		info = &behaviourInfo{
+			name:         name,
+			baseType:     baseType,
+			propertyByGd: map[string]int{},
+			methodByGd:   map[string]int{},
+		}
+		implType = reflect.TypeFor[*BehaviourImpl]()
+	)
+	// methods
+	for i := 0; i < t.NumMethod(); i++ {
+		m := t.Method(i)
+		if _, ok := implType.MethodByName(m.Name); ok {
+			continue
+		}
+		if m.Name == "V_Notification" {
+			mt := m.Type
+			if mt.NumIn() != 3 || mt.In(1) != reflect.TypeFor[int32]() || mt.In(2) != reflect.TypeFor[bool]() || mt.NumOut() != 0 {
+				errs = append(errs, fmt.Errorf("method V_Notification must have the signature func(what int32, reversed bool)"))
+			}
+			info.notification = true
+			continue
+		}
+		bm, methodErrs := newBehaviourMethod(m)
+		for _, err := range methodErrs {
+			errs = append(errs, fmt.Errorf("method %s: %w", m.Name, err))
+		}
+		if j, ok := info.methodByGd[bm.gdName]; ok {
+			errs = append(errs, fmt.Errorf("method %s: name %q is already used by %s", m.Name, bm.gdName, info.methods[j].goName))
+		}
+		info.methodByGd[bm.gdName] = len(info.methods)
+		info.methods = append(info.methods, bm)
+	}
+	// properties in field order
+	for _, f := range reflect.VisibleFields(t.Elem()) {
+		if f.Anonymous {
+			continue
+		}
+		propName, po, ok, err := FieldPropertyTag(f)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if !f.IsExported() {
+			errs = append(errs, fmt.Errorf("property field %s must be exported", f.Name))
+			continue
+		}
+		vt, ok := GoTypeVariantType(f.Type)
+		if !ok {
+			errs = append(errs, fmt.Errorf("property field %s: unsupported type %v", f.Name, f.Type))
+			continue
+		}
+		if _, ok := info.propertyByGd[propName]; ok {
+			errs = append(errs, fmt.Errorf("property field %s: name %q is already used", f.Name, propName))
+			continue
+		}
+		po.Usage |= PROPERTY_USAGE_SCRIPT_VARIABLE
+		if vt == GDEXTENSION_VARIANT_TYPE_NIL {
+			po.Usage |= PROPERTY_USAGE_NIL_IS_VARIANT
+		}
+		info.propertyByGd[propName] = len(info.properties)
+		info.properties = append(info.properties, behaviourProperty{
+			name:        propName,
+			fieldIndex:  f.Index,
+			fieldType:   f.Type,
+			variantType: vt,
+			options:     po,
+		})
+	}
+	return info, errs
+}
+
+func newBehaviourMethod(m reflect.Method) (behaviourMethod, []error) {
+	var (
+		errs []error
+		mt   = m.Type
+		bm   = behaviourMethod{
+			gdName:   GodotMethodName(m.Name),
+			goName:   m.Name,
+			variadic: mt.IsVariadic(),
+		}
+	)
+	// the receiver is not an argument
+	for i := 1; i < mt.NumIn(); i++ {
+		at := mt.In(i)
+		if bm.variadic && i == mt.NumIn()-1 {
+			if at.Elem() != reflect.TypeFor[Variant]() {
+				errs = append(errs, fmt.Errorf("variadic argument must be ...Variant, not %v", at))
+			}
+			continue
+		}
+		vt, ok := GoTypeVariantType(at)
+		if !ok {
+			errs = append(errs, fmt.Errorf("argument %d: unsupported type %v", i-1, at))
+		}
+		bm.argTypes = append(bm.argTypes, vt)
+	}
+	switch mt.NumOut() {
+	case 0:
+	case 1:
+		vt, ok := GoTypeVariantType(mt.Out(0))
+		if !ok {
+			errs = append(errs, fmt.Errorf("return value: unsupported type %v", mt.Out(0)))
+		}
+		bm.returnType = vt
+		bm.hasReturn = true
+	default:
+		errs = append(errs, fmt.Errorf("cannot return more than 1 value"))
+	}
+	return bm, errs
+}
+
+// methodFlags returns the flags the method is reported with.
+func (m behaviourMethod) methodFlags() MethodFlags {
+	flags := METHOD_FLAGS_DEFAULT
+	if m.variadic {
+		flags |= METHOD_FLAG_VARARG
+	}
+	if strings.HasPrefix(m.gdName, "_") {
+		flags |= METHOD_FLAG_VIRTUAL
+	}
+	return flags
+}
+
+// propertyDefaults returns the values a new behaviour initializes its
+// properties with, keyed by property name. The Variants are owned by the
+// caller.
+func (b *behaviourInfo) propertyDefaults() map[string]Variant {
+	v := reflect.ValueOf(b.newValue()).Elem()
+	defaults := make(map[string]Variant, len(b.properties))
+	for _, p := range b.properties {
+		defaults[p.name] = variantFromValue(v.FieldByIndex(p.fieldIndex))
+	}
+	return defaults
+}
+
+// variantFromValue converts a value of a type supported by GoTypeVariantType
+// into a Variant owned by the caller.
+func variantFromValue(rv reflect.Value) Variant {
+	var ret Variant
+	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
+	pnr.Pin(ptr)
+	if !EncodeVariantPtr(rv, ptr) {
+		log.Panic("unsupported behaviour value type",
+			zap.String("type", rv.Type().String()),
+		)
+	}
+	return ret
+}
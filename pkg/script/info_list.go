@@ -0,0 +1,142 @@
+package script
+
+import (
+	"runtime"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/constant"
+	. "github.com/godot-go/godot-go/pkg/core"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	. "github.com/godot-go/godot-go/pkg/util"
+)
+
+// infoList owns the memory of a property or method list handed to Godot; it
+// is released when Godot calls the matching free callback.
+type infoList struct {
+	pnr         runtime.Pinner
+	stringNames []*StringName
+	strings     []*String
+}
+
+// infoLists holds the lists Godot has not freed yet, keyed by the pointer
+// returned to Godot.
+var infoLists = NewSyncMap[unsafe.Pointer, *infoList]()
+
+func (l *infoList) stringName(v string) *StringName {
+	sn := new(StringName)
+	*sn = NewStringNameWithUtf8Chars(v)
+	l.pnr.Pin(sn)
+	l.stringNames = append(l.stringNames, sn)
+	return sn
+}
+
+func (l *infoList) string(v string) *String {
+	s := new(String)
+	*s = NewStringWithUtf8Chars(v)
+	l.pnr.Pin(s)
+	l.strings = append(l.strings, s)
+	return s
+}
+
+func (l *infoList) propertyInfo(vt GDExtensionVariantType, name string, po PropertyOptions) GDExtensionPropertyInfo {
+	return NewGDExtensionPropertyInfo(
+		l.stringName(po.ClassName).AsGDExtensionConstStringNamePtr(),
+		vt,
+		l.stringName(name).AsGDExtensionConstStringNamePtr(),
+		uint32(po.Hint),
+		l.string(po.HintString).AsGDExtensionConstStringPtr(),
+		uint32(po.Usage),
+	)
+}
+
+// keepInfoList pins the backing array of list and keeps l alive until
+// freeInfoList is called with the returned pointer.
+func keepInfoList[T any](l *infoList, list []T) *T {
+	if len(list) == 0 {
+		l.release()
+		return nil
+	}
+	ptr := unsafe.SliceData(list)
+	l.pnr.Pin(ptr)
+	infoLists.Set(unsafe.Pointer(ptr), l)
+	return ptr
+}
+
+// freeInfoList releases the list returned for ptr.
+func freeInfoList(ptr unsafe.Pointer) {
+	if ptr == nil {
+		return
+	}
+	l, ok := infoLists.Get(ptr)
+	if !ok {
+		return
+	}
+	infoLists.Delete(ptr)
+	l.release()
+}
+
+func (l *infoList) release() {
+	for _, sn := range l.stringNames {
+		sn.Destroy()
+	}
+	for _, s := range l.strings {
+		s.Destroy()
+	}
+	l.stringNames = nil
+	l.strings = nil
+	l.pnr.Unpin()
+}
+
+// propertyInfos returns the script properties of the behaviour.
+func (b *behaviourInfo) propertyInfos(l *infoList) []GDExtensionPropertyInfo {
+	infos := make([]GDExtensionPropertyInfo, len(b.properties))
+	for i, p := range b.properties {
+		infos[i] = l.propertyInfo(p.variantType, p.name, p.options)
+	}
+	return infos
+}
+
+// methodInfos returns the methods of the behaviour.
+func (b *behaviourInfo) methodInfos(l *infoList) []GDExtensionMethodInfo {
+	infos := make([]GDExtensionMethodInfo, len(b.methods))
+	for i, m := range b.methods {
+		args := make([]GDExtensionPropertyInfo, len(m.argTypes))
+		for j, vt := range m.argTypes {
+			args[j] = l.propertyInfo(vt, argumentName(j), argumentPropertyOptions(vt))
+		}
+		var argsPtr *GDExtensionPropertyInfo
+		if len(args) > 0 {
+			argsPtr = unsafe.SliceData(args)
+			l.pnr.Pin(argsPtr)
+		}
+		ret := l.propertyInfo(GDEXTENSION_VARIANT_TYPE_NIL, "", PropertyOptions{})
+		if m.hasReturn {
+			ret = l.propertyInfo(m.returnType, "", argumentPropertyOptions(m.returnType))
+		}
+		infos[i] = NewGDExtensionMethodInfo(
+			(GDExtensionStringNamePtr)(unsafe.Pointer(l.stringName(m.gdName))),
+			ret,
+			uint32(m.methodFlags()),
+			0,
+			uint32(len(args)),
+			argsPtr,
+			0,
+			nil,
+		)
+	}
+	return infos
+}
+
+// argumentPropertyOptions returns the options of a method argument or return
+// value; Variant values are flagged as such instead of being reported as nil.
+func argumentPropertyOptions(vt GDExtensionVariantType) PropertyOptions {
+	po := PropertyOptions{
+		Hint:  PROPERTY_HINT_NONE,
+		Usage: PROPERTY_USAGE_DEFAULT,
+	}
+	if vt == GDEXTENSION_VARIANT_TYPE_NIL {
+		po.Usage |= PROPERTY_USAGE_NIL_IS_VARIANT
+	}
+	return po
+}
@@ -0,0 +1,285 @@
+package script
+
+// #include <godot/gdextension_interface.h>
+// #include "script_instance.h"
+import "C"
+import (
+	"fmt"
+	"reflect"
+	"runtime/cgo"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// scriptInstance is the instance data of a GoScript attached to an object.
+type scriptInstance struct {
+	script  *GoScript
+	owner   *GodotObject
+	id      GDObjectInstanceID
+	info    *behaviourInfo
+	value   reflect.Value
+	methods []VariantFunc
+}
+
+// scriptInstanceInfo is shared by every script instance; Godot keeps a
+// pointer to it for the lifetime of the instances.
+var scriptInstanceInfo = NewGDExtensionScriptInstanceInfo3(
+	(GDExtensionScriptInstanceSet)(C.cgo_script_instance_set),
+	(GDExtensionScriptInstanceGet)(C.cgo_script_instance_get),
+	(GDExtensionScriptInstanceGetPropertyList)(C.cgo_script_instance_get_property_list),
+	(GDExtensionScriptInstanceFreePropertyList2)(C.cgo_script_instance_free_property_list),
+	nil,
+	nil,
+	nil,
+	(GDExtensionScriptInstanceGetOwner)(C.cgo_script_instance_get_owner),
+	nil,
+	(GDExtensionScriptInstanceGetMethodList)(C.cgo_script_instance_get_method_list),
+	(GDExtensionScriptInstanceFreeMethodList2)(C.cgo_script_instance_free_method_list),
+	(GDExtensionScriptInstanceGetPropertyType)(C.cgo_script_instance_get_property_type),
+	nil,
+	(GDExtensionScriptInstanceHasMethod)(C.cgo_script_instance_has_method),
+	(GDExtensionScriptInstanceGetMethodArgumentCount)(C.cgo_script_instance_get_method_argument_count),
+	(GDExtensionScriptInstanceCall)(C.cgo_script_instance_call),
+	(GDExtensionScriptInstanceNotification2)(C.cgo_script_instance_notification),
+	(GDExtensionScriptInstanceToString)(C.cgo_script_instance_to_string),
+	nil,
+	nil,
+	(GDExtensionScriptInstanceGetScript)(C.cgo_script_instance_get_script),
+	(GDExtensionScriptInstanceIsPlaceholder)(C.cgo_script_instance_is_placeholder),
+	nil,
+	nil,
+	(GDExtensionScriptInstanceGetLanguage)(C.cgo_script_instance_get_language),
+	(GDExtensionScriptInstanceFree)(C.cgo_script_instance_free),
+)
+
+// newScriptInstance creates the behaviour of script for owner and the
+// engine script instance wrapping it.
+func newScriptInstance(script *GoScript, info *behaviourInfo, owner Object) GDExtensionScriptInstancePtr {
+	b := info.newValue()
+	b.attach(owner)
+	v := reflect.ValueOf(b)
+	si := &scriptInstance{
+		script:  script,
+		owner:   owner.GetGodotObjectOwner(),
+		id:      CallFunc_GDExtensionInterfaceObjectGetInstanceId((GDExtensionConstObjectPtr)(owner.GetGodotObjectOwner())),
+		info:    info,
+		value:   v,
+		methods: make([]VariantFunc, len(info.methods)),
+	}
+	for i, m := range info.methods {
+		si.methods[i] = NewVariantFunc(v.MethodByName(m.goName).Interface())
+	}
+	script.addInstance(si)
+	handle := cgo.NewHandle(si)
+	log.Debug("script instance created",
+		zap.String("behaviour", info.name),
+		zap.Any("owner_id", si.id),
+	)
+	return CallFunc_GDExtensionInterfaceScriptInstanceCreate3(&scriptInstanceInfo, (GDExtensionScriptInstanceDataPtr)(unsafe.Pointer(handle)))
+}
+
+func scriptInstanceFromData(p C.GDExtensionScriptInstanceDataPtr) *scriptInstance {
+	si, ok := cgo.Handle(p).Value().(*scriptInstance)
+	if !ok || si == nil {
+		log.Panic("unable to retrieve script instance data")
+	}
+	return si
+}
+
+func goStringFromStringNamePtr(p C.GDExtensionConstStringNamePtr) string {
+	return (*StringName)(unsafe.Pointer(p)).ToUtf8()
+}
+
+func (si *scriptInstance) property(name string) (behaviourProperty, reflect.Value, bool) {
+	i, ok := si.info.propertyByGd[name]
+	if !ok {
+		return behaviourProperty{}, reflect.Value{}, false
+	}
+	p := si.info.properties[i]
+	return p, si.value.Elem().FieldByIndex(p.fieldIndex), true
+}
+
+//export GoCallback_ScriptInstanceSet
+func GoCallback_ScriptInstanceSet(pInstance C.GDExtensionScriptInstanceDataPtr, pName C.GDExtensionConstStringNamePtr, pValue C.GDExtensionConstVariantPtr) C.GDExtensionBool {
+	si := scriptInstanceFromData(pInstance)
+	name := goStringFromStringNamePtr(pName)
+	p, field, ok := si.property(name)
+	if !ok {
+		return 0
+	}
+	v, ok := DecodeVariantPtr((GDExtensionConstVariantPtr)(pValue), p.fieldType)
+	if !ok {
+		log.Warn("invalid value type for script property",
+			zap.String("behaviour", si.info.name),
+			zap.String("property", name),
+		)
+		return 0
+	}
+	field.Set(v)
+	return 1
+}
+
+//export GoCallback_ScriptInstanceGet
+func GoCallback_ScriptInstanceGet(pInstance C.GDExtensionScriptInstanceDataPtr, pName C.GDExtensionConstStringNamePtr, rRet C.GDExtensionVariantPtr) C.GDExtensionBool {
+	si := scriptInstanceFromData(pInstance)
+	name := goStringFromStringNamePtr(pName)
+	_, field, ok := si.property(name)
+	if !ok {
+		return 0
+	}
+	if !EncodeVariantPtr(field, (GDExtensionUninitializedVariantPtr)(rRet)) {
+		log.Warn("unable to encode script property",
+			zap.String("behaviour", si.info.name),
+			zap.String("property", name),
+		)
+		return 0
+	}
+	return 1
+}
+
+//export GoCallback_ScriptInstanceGetPropertyList
+func GoCallback_ScriptInstanceGetPropertyList(pInstance C.GDExtensionScriptInstanceDataPtr, rCount *C.uint32_t) *C.GDExtensionPropertyInfo {
+	si := scriptInstanceFromData(pInstance)
+	l := &infoList{}
+	properties := si.info.propertyInfos(l)
+	*rCount = (C.uint32_t)(len(properties))
+	return (*C.GDExtensionPropertyInfo)(unsafe.Pointer(keepInfoList(l, properties)))
+}
+
+//export GoCallback_ScriptInstanceFreePropertyList
+func GoCallback_ScriptInstanceFreePropertyList(pInstance C.GDExtensionScriptInstanceDataPtr, pList *C.GDExtensionPropertyInfo, pCount C.uint32_t) {
+	freeInfoList(unsafe.Pointer(pList))
+}
+
+//export GoCallback_ScriptInstanceGetOwner
+func GoCallback_ScriptInstanceGetOwner(pInstance C.GDExtensionScriptInstanceDataPtr) C.GDExtensionObjectPtr {
+	si := scriptInstanceFromData(pInstance)
+	return (C.GDExtensionObjectPtr)(unsafe.Pointer(si.owner))
+}
+
+//export GoCallback_ScriptInstanceGetMethodList
+func GoCallback_ScriptInstanceGetMethodList(pInstance C.GDExtensionScriptInstanceDataPtr, rCount *C.uint32_t) *C.GDExtensionMethodInfo {
+	si := scriptInstanceFromData(pInstance)
+	l := &infoList{}
+	methods := si.info.methodInfos(l)
+	*rCount = (C.uint32_t)(len(methods))
+	return (*C.GDExtensionMethodInfo)(unsafe.Pointer(keepInfoList(l, methods)))
+}
+
+//export GoCallback_ScriptInstanceFreeMethodList
+func GoCallback_ScriptInstanceFreeMethodList(pInstance C.GDExtensionScriptInstanceDataPtr, pList *C.GDExtensionMethodInfo, pCount C.uint32_t) {
+	freeInfoList(unsafe.Pointer(pList))
+}
+
+//export GoCallback_ScriptInstanceGetPropertyType
+func GoCallback_ScriptInstanceGetPropertyType(pInstance C.GDExtensionScriptInstanceDataPtr, pName C.GDExtensionConstStringNamePtr, rIsValid *C.GDExtensionBool) C.GDExtensionVariantType {
+	si := scriptInstanceFromData(pInstance)
+	p, _, ok := si.property(goStringFromStringNamePtr(pName))
+	if !ok {
+		*rIsValid = 0
+		return (C.GDExtensionVariantType)(GDEXTENSION_VARIANT_TYPE_NIL)
+	}
+	*rIsValid = 1
+	return (C.GDExtensionVariantType)(p.variantType)
+}
+
+//export GoCallback_ScriptInstanceHasMethod
+func GoCallback_ScriptInstanceHasMethod(pInstance C.GDExtensionScriptInstanceDataPtr, pName C.GDExtensionConstStringNamePtr) C.GDExtensionBool {
+	si := scriptInstanceFromData(pInstance)
+	if _, ok := si.info.methodByGd[goStringFromStringNamePtr(pName)]; ok {
+		return 1
+	}
+	return 0
+}
+
+//export GoCallback_ScriptInstanceGetMethodArgumentCount
+func GoCallback_ScriptInstanceGetMethodArgumentCount(pInstance C.GDExtensionScriptInstanceDataPtr, pName C.GDExtensionConstStringNamePtr, rIsValid *C.GDExtensionBool) C.GDExtensionInt {
+	si := scriptInstanceFromData(pInstance)
+	i, ok := si.info.methodByGd[goStringFromStringNamePtr(pName)]
+	if !ok {
+		*rIsValid = 0
+		return 0
+	}
+	// variadic methods report their fixed arguments
+	count, _ := si.methods[i].ArgumentCount()
+	*rIsValid = 1
+	return (C.GDExtensionInt)(count)
+}
+
+//export GoCallback_ScriptInstanceCall
+func GoCallback_ScriptInstanceCall(
+	pSelf C.GDExtensionScriptInstanceDataPtr,
+	pMethod C.GDExtensionConstStringNamePtr,
+	pArgs *C.GDExtensionConstVariantPtr,
+	argumentCount C.GDExtensionInt,
+	rReturn C.GDExtensionVariantPtr,
+	rError *C.GDExtensionCallError,
+) {
+	si := scriptInstanceFromData(pSelf)
+	callError := (*GDExtensionCallError)(unsafe.Pointer(rError))
+	i, ok := si.info.methodByGd[goStringFromStringNamePtr(pMethod)]
+	if !ok {
+		// the engine calls every virtual method on the script first and
+		// falls back to the owner's implementation on this error
+		callError.SetErrorFields(GDEXTENSION_CALL_ERROR_INVALID_METHOD, 0, 0)
+		return
+	}
+	args := unsafe.Slice((*GDExtensionConstVariantPtr)(pArgs), int(argumentCount))
+	si.methods[i].Call(args, (GDExtensionVariantPtr)(rReturn), callError)
+}
+
+//export GoCallback_ScriptInstanceNotification
+func GoCallback_ScriptInstanceNotification(pInstance C.GDExtensionScriptInstanceDataPtr, what C.int32_t, reversed C.GDExtensionBool) {
+	si := scriptInstanceFromData(pInstance)
+	if !si.info.notification {
+		return
+	}
+	si.value.MethodByName("V_Notification").Call([]reflect.Value{
+		reflect.ValueOf(int32(what)),
+		reflect.ValueOf(reversed != 0),
+	})
+}
+
+//export GoCallback_ScriptInstanceToString
+func GoCallback_ScriptInstanceToString(pInstance C.GDExtensionScriptInstanceDataPtr, rIsValid *C.GDExtensionBool, rOut C.GDExtensionStringPtr) {
+	si := scriptInstanceFromData(pInstance)
+	s, ok := si.value.Interface().(fmt.Stringer)
+	if !ok {
+		*rIsValid = 0
+		return
+	}
+	GDExtensionStringPtrWithUtf8Chars((GDExtensionStringPtr)(rOut), s.String())
+	*rIsValid = 1
+}
+
+//export GoCallback_ScriptInstanceGetScript
+func GoCallback_ScriptInstanceGetScript(pInstance C.GDExtensionScriptInstanceDataPtr) C.GDExtensionObjectPtr {
+	si := scriptInstanceFromData(pInstance)
+	return (C.GDExtensionObjectPtr)(unsafe.Pointer(si.script.GetGodotObjectOwner()))
+}
+
+//export GoCallback_ScriptInstanceIsPlaceholder
+func GoCallback_ScriptInstanceIsPlaceholder(pInstance C.GDExtensionScriptInstanceDataPtr) C.GDExtensionBool {
+	// editor placeholders are created by the engine and never reach Go
+	return 0
+}
+
+//export GoCallback_ScriptInstanceGetLanguage
+func GoCallback_ScriptInstanceGetLanguage(pInstance C.GDExtensionScriptInstanceDataPtr) C.GDExtensionScriptLanguagePtr {
+	return (C.GDExtensionScriptLanguagePtr)(unsafe.Pointer(language.GetGodotObjectOwner()))
+}
+
+//export GoCallback_ScriptInstanceFree
+func GoCallback_ScriptInstanceFree(pInstance C.GDExtensionScriptInstanceDataPtr) {
+	si := scriptInstanceFromData(pInstance)
+	cgo.Handle(pInstance).Delete()
+	si.script.removeInstance(si)
+	log.Debug("script instance freed",
+		zap.String("behaviour", si.info.name),
+		zap.Any("owner_id", si.id),
+	)
+}
@@ -0,0 +1,171 @@
+package script
+
+import (
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/core"
+	. "github.com/godot-go/godot-go/pkg/gdclassimpl"
+)
+
+// GoScriptLanguage implements GDClass evidence
+var _ GDClass = (*GoScriptLanguage)(nil)
+
+// GoScriptLanguage is the script language of GoScripts. A single instance is
+// registered with the engine by Register.
+type GoScriptLanguage struct {
+	ScriptLanguageExtensionImpl
+}
+
+func (l *GoScriptLanguage) GetClassName() string {
+	return "GoScriptLanguage"
+}
+
+func (l *GoScriptLanguage) GetParentClassName() string {
+	return "ScriptLanguageExtension"
+}
+
+func (l *GoScriptLanguage) V_GetName() string {
+	return "Go"
+}
+
+func (l *GoScriptLanguage) V_Init() {
+}
+
+func (l *GoScriptLanguage) V_GetType() string {
+	return "GoScript"
+}
+
+func (l *GoScriptLanguage) V_GetExtension() string {
+	return "goscript"
+}
+
+func (l *GoScriptLanguage) V_Finish() {
+}
+
+func (l *GoScriptLanguage) V_GetReservedWords() PackedStringArray {
+	return NewPackedStringArray()
+}
+
+func (l *GoScriptLanguage) V_IsControlFlowKeyword(keyword string) bool {
+	return false
+}
+
+func (l *GoScriptLanguage) V_GetCommentDelimiters() PackedStringArray {
+	return NewPackedStringArray()
+}
+
+func (l *GoScriptLanguage) V_GetDocCommentDelimiters() PackedStringArray {
+	return NewPackedStringArray()
+}
+
+func (l *GoScriptLanguage) V_GetStringDelimiters() PackedStringArray {
+	return NewPackedStringArray()
+}
+
+func (l *GoScriptLanguage) V_IsUsingTemplates() bool {
+	return false
+}
+
+// V_Validate reports every GoScript as valid; behaviours are checked by
+// RegisterBehaviour when the extension is loaded.
+func (l *GoScriptLanguage) V_Validate(script string, path string, validateFunctions bool, validateErrors bool, validateWarnings bool, validateSafeLines bool) Dictionary {
+	ret := NewDictionary()
+	valid := NewVariantBool(true)
+	defer valid.Destroy()
+	ret.SetKeyed("valid", valid)
+	return ret
+}
+
+func (l *GoScriptLanguage) V_ValidatePath(path string) string {
+	return ""
+}
+
+func (l *GoScriptLanguage) V_CreateScript() Object {
+	return CreateGDClassInstance("GoScript").(*GoScript)
+}
+
+func (l *GoScriptLanguage) V_HasNamedClasses() bool {
+	return false
+}
+
+// V_SupportsBuiltinMode is true as GoScripts have no source file; they are
+// saved inside the scene or resource that uses them.
+func (l *GoScriptLanguage) V_SupportsBuiltinMode() bool {
+	return true
+}
+
+func (l *GoScriptLanguage) V_SupportsDocumentation() bool {
+	return false
+}
+
+func (l *GoScriptLanguage) V_CanInheritFromFile() bool {
+	return false
+}
+
+func (l *GoScriptLanguage) V_CanMakeFunction() bool {
+	return false
+}
+
+func (l *GoScriptLanguage) V_OverridesExternalEditor() bool {
+	return false
+}
+
+func (l *GoScriptLanguage) V_AddGlobalConstant(name StringName, value Variant) {
+}
+
+func (l *GoScriptLanguage) V_AddNamedGlobalConstant(name StringName, value Variant) {
+}
+
+func (l *GoScriptLanguage) V_RemoveNamedGlobalConstant(name StringName) {
+}
+
+func (l *GoScriptLanguage) V_ThreadEnter() {
+}
+
+func (l *GoScriptLanguage) V_ThreadExit() {
+}
+
+func (l *GoScriptLanguage) V_DebugGetError() string {
+	return ""
+}
+
+func (l *GoScriptLanguage) V_DebugGetStackLevelCount() int32 {
+	return 0
+}
+
+func (l *GoScriptLanguage) V_ReloadAllScripts() {
+}
+
+func (l *GoScriptLanguage) V_GetRecognizedExtensions() PackedStringArray {
+	return NewPackedStringArray()
+}
+
+func (l *GoScriptLanguage) V_GetPublicFunctions() Array {
+	return NewArray()
+}
+
+func (l *GoScriptLanguage) V_GetPublicConstants() Dictionary {
+	return NewDictionary()
+}
+
+func (l *GoScriptLanguage) V_GetPublicAnnotations() Array {
+	return NewArray()
+}
+
+func (l *GoScriptLanguage) V_ProfilingStart() {
+}
+
+func (l *GoScriptLanguage) V_ProfilingStop() {
+}
+
+func (l *GoScriptLanguage) V_Frame() {
+}
+
+func (l *GoScriptLanguage) V_HandlesGlobalClassType(typeName string) bool {
+	return false
+}
+
+func NewGoScriptLanguageFromOwnerObject(owner *GodotObject) GDClass {
+	obj := &GoScriptLanguage{}
+	obj.SetGodotObjectOwner(owner)
+	return obj
+}
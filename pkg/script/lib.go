@@ -0,0 +1,69 @@
+package script
+
+/*
+#cgo CFLAGS: -I${SRCDIR}/../../godot_headers -I${SRCDIR}/../../pkg/log -I${SRCDIR}/../../pkg/script
+#include <godot/gdextension_interface.h>
+#include "script_instance.h"
+*/
+import "C"
+import (
+	"runtime"
+	"strings"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/constant"
+	. "github.com/godot-go/godot-go/pkg/core"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	. "github.com/godot-go/godot-go/pkg/gdclassimpl"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+var (
+	pnr runtime.Pinner
+	// language is the GoScriptLanguage registered with the engine.
+	language *GoScriptLanguage
+)
+
+// Register registers the GoScript classes and the Go script language with
+// the engine. It is called from a scene initializer after the behaviours are
+// registered so the editor can list them.
+func Register() {
+	if err := ClassDBRegisterClassAuto[*GoScriptLanguage](NewGoScriptLanguageFromOwnerObject, nil); err != nil {
+		log.Panic("unable to register GoScriptLanguage", zap.Error(err))
+	}
+	if err := ClassDBRegisterClassAuto[*GoScript](NewGoScriptFromOwnerObject, func(s *GoScript) {
+		ClassDBAddProperty(s, GDEXTENSION_VARIANT_TYPE_STRING, "behaviour", "set_behaviour", "get_behaviour",
+			WithPropertyHint(PROPERTY_HINT_ENUM_SUGGESTION, strings.Join(BehaviourNames(), ",")),
+		)
+	}); err != nil {
+		log.Panic("unable to register GoScript", zap.Error(err))
+	}
+	language = CreateGDClassInstance("GoScriptLanguage").(*GoScriptLanguage)
+	if err := engineSingleton().RegisterScriptLanguage(language); err != OK {
+		log.Panic("unable to register the Go script language",
+			zap.Any("error", err),
+		)
+	}
+	log.Debug("Go script language registered")
+}
+
+// Unregister removes the Go script language from the engine and unregisters
+// the GoScript classes.
+func Unregister() {
+	if language != nil {
+		engineSingleton().UnregisterScriptLanguage(language)
+		CallFunc_GDExtensionInterfaceObjectDestroy(language.AsGDExtensionObjectPtr())
+		language = nil
+	}
+	ClassDBUnregisterClass[*GoScript]()
+	ClassDBUnregisterClass[*GoScriptLanguage]()
+	pnr.Unpin()
+	log.Debug("Go script language unregistered")
+}
+
+func engineSingleton() Engine {
+	owner := (*GodotObject)(unsafe.Pointer(GetSingleton("Engine")))
+	return NewEngineWithGodotOwnerObject(owner)
+}
@@ -0,0 +1,424 @@
+package script
+
+import (
+	"fmt"
+	"sync"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/constant"
+	. "github.com/godot-go/godot-go/pkg/core"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	. "github.com/godot-go/godot-go/pkg/gdclassimpl"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// GoScript implements GDClass evidence
+var _ GDClass = (*GoScript)(nil)
+
+// GoScript is a script resource that attaches a behaviour registered with
+// RegisterBehaviour to the object it is assigned to. GoScripts have no source
+// code; the behaviour property names the Go type to instantiate.
+type GoScript struct {
+	ScriptExtensionImpl
+	behaviour    string
+	mu           sync.Mutex
+	instances    map[GDObjectInstanceID]*scriptInstance
+	placeholders map[GDExtensionScriptInstancePtr]struct{}
+}
+
+func (s *GoScript) GetClassName() string {
+	return "GoScript"
+}
+
+func (s *GoScript) GetParentClassName() string {
+	return "ScriptExtension"
+}
+
+func (s *GoScript) GetBehaviour() string {
+	return s.behaviour
+}
+
+// SetBehaviour changes the behaviour of the script; objects the script is
+// already attached to keep their behaviour until the script is reassigned.
+func (s *GoScript) SetBehaviour(behaviour string) {
+	s.behaviour = behaviour
+	s.updatePlaceholders()
+}
+
+// behaviourInfo returns the registered behaviour of the script.
+func (s *GoScript) behaviourInfo() (*behaviourInfo, bool) {
+	return behaviours.Get(s.behaviour)
+}
+
+func (s *GoScript) addInstance(si *scriptInstance) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.instances == nil {
+		s.instances = map[GDObjectInstanceID]*scriptInstance{}
+	}
+	s.instances[si.id] = si
+}
+
+func (s *GoScript) removeInstance(si *scriptInstance) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.instances[si.id] == si {
+		delete(s.instances, si.id)
+	}
+}
+
+func (s *GoScript) V_EditorCanReloadFromFile() bool {
+	return false
+}
+
+// V_CanInstantiate reports whether real instances are created; in the
+// editor, objects get placeholder instances that only hold property values.
+func (s *GoScript) V_CanInstantiate() bool {
+	return s.V_IsValid() && !engineSingleton().IsEditorHint()
+}
+
+func (s *GoScript) V_GetBaseScript() Object {
+	return nil
+}
+
+func (s *GoScript) V_GetGlobalName() StringName {
+	return NewStringName()
+}
+
+func (s *GoScript) V_InheritsScript(script Object) bool {
+	return false
+}
+
+func (s *GoScript) V_GetInstanceBaseType() StringName {
+	info, ok := s.behaviourInfo()
+	if !ok {
+		return NewStringNameWithLatin1Chars("Object")
+	}
+	return NewStringNameWithUtf8Chars(info.baseType)
+}
+
+func (s *GoScript) V_InstanceCreate(forObject Object) unsafe.Pointer {
+	info, ok := s.behaviourInfo()
+	if !ok {
+		log.Error("GoScript behaviour is not registered",
+			zap.String("behaviour", s.behaviour),
+		)
+		return nil
+	}
+	return unsafe.Pointer(newScriptInstance(s, info, forObject))
+}
+
+func (s *GoScript) V_PlaceholderInstanceCreate(forObject Object) unsafe.Pointer {
+	placeholder := CallFunc_GDExtensionInterfacePlaceHolderScriptInstanceCreate(
+		language.AsGDExtensionObjectPtr(),
+		s.AsGDExtensionObjectPtr(),
+		forObject.AsGDExtensionObjectPtr(),
+	)
+	s.mu.Lock()
+	if s.placeholders == nil {
+		s.placeholders = map[GDExtensionScriptInstancePtr]struct{}{}
+	}
+	s.placeholders[placeholder] = struct{}{}
+	s.mu.Unlock()
+	s.updatePlaceholder(placeholder)
+	return unsafe.Pointer(placeholder)
+}
+
+func (s *GoScript) V_PlaceholderErased(placeholder unsafe.Pointer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.placeholders, (GDExtensionScriptInstancePtr)(placeholder))
+}
+
+func (s *GoScript) V_InstanceHas(object Object) bool {
+	if object == nil {
+		return false
+	}
+	id := CallFunc_GDExtensionInterfaceObjectGetInstanceId(object.AsGDExtensionConstObjectPtr())
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.instances[id]
+	return ok
+}
+
+func (s *GoScript) V_HasSourceCode() bool {
+	return false
+}
+
+func (s *GoScript) V_GetSourceCode() string {
+	return ""
+}
+
+func (s *GoScript) V_SetSourceCode(code string) {
+}
+
+func (s *GoScript) V_Reload(keepState bool) Error {
+	return OK
+}
+
+func (s *GoScript) V_GetDocumentation() Array {
+	return NewArray()
+}
+
+func (s *GoScript) V_HasMethod(method StringName) bool {
+	info, ok := s.behaviourInfo()
+	if !ok {
+		return false
+	}
+	_, ok = info.methodByGd[method.ToUtf8()]
+	return ok
+}
+
+func (s *GoScript) V_HasStaticMethod(method StringName) bool {
+	return false
+}
+
+// V_GetScriptMethodArgumentCount returns the argument count as an int
+// Variant, or nil if the method does not exist.
+func (s *GoScript) V_GetScriptMethodArgumentCount(method StringName) Variant {
+	info, ok := s.behaviourInfo()
+	if !ok {
+		return NewVariantNil()
+	}
+	i, ok := info.methodByGd[method.ToUtf8()]
+	if !ok {
+		return NewVariantNil()
+	}
+	return NewVariantInt64(int64(len(info.methods[i].argTypes)))
+}
+
+func (s *GoScript) V_GetMethodInfo(method StringName) Dictionary {
+	info, ok := s.behaviourInfo()
+	if !ok {
+		return NewDictionary()
+	}
+	i, ok := info.methodByGd[method.ToUtf8()]
+	if !ok {
+		return NewDictionary()
+	}
+	return methodDictionary(info.methods[i])
+}
+
+func (s *GoScript) V_IsTool() bool {
+	return false
+}
+
+func (s *GoScript) V_IsValid() bool {
+	_, ok := s.behaviourInfo()
+	return ok
+}
+
+func (s *GoScript) V_IsAbstract() bool {
+	return false
+}
+
+func (s *GoScript) V_GetLanguage() Object {
+	return language
+}
+
+func (s *GoScript) V_HasScriptSignal(signal StringName) bool {
+	return false
+}
+
+func (s *GoScript) V_GetScriptSignalList() Array {
+	return NewArray()
+}
+
+func (s *GoScript) V_HasPropertyDefaultValue(property StringName) bool {
+	info, ok := s.behaviourInfo()
+	if !ok {
+		return false
+	}
+	_, ok = info.propertyByGd[property.ToUtf8()]
+	return ok
+}
+
+func (s *GoScript) V_GetPropertyDefaultValue(property StringName) Variant {
+	info, ok := s.behaviourInfo()
+	if !ok {
+		return NewVariantNil()
+	}
+	defaults := info.propertyDefaults()
+	ret := NewVariantNil()
+	for name, v := range defaults {
+		if name == property.ToUtf8() {
+			ret = v
+			continue
+		}
+		v.Destroy()
+	}
+	return ret
+}
+
+// V_UpdateExports refreshes the properties and values shown for placeholder
+// instances in the editor.
+func (s *GoScript) V_UpdateExports() {
+	s.updatePlaceholders()
+}
+
+func (s *GoScript) V_GetScriptMethodList() Array {
+	ret := NewArray()
+	info, ok := s.behaviourInfo()
+	if !ok {
+		return ret
+	}
+	for _, m := range info.methods {
+		d := methodDictionary(m)
+		v := NewVariantDictionary(d)
+		ret.Append(v)
+		v.Destroy()
+		d.Destroy()
+	}
+	return ret
+}
+
+func (s *GoScript) V_GetScriptPropertyList() Array {
+	ret := NewArray()
+	info, ok := s.behaviourInfo()
+	if !ok {
+		return ret
+	}
+	for _, p := range info.properties {
+		d := propertyDictionary(p.variantType, p.name, p.options)
+		v := NewVariantDictionary(d)
+		ret.Append(v)
+		v.Destroy()
+		d.Destroy()
+	}
+	return ret
+}
+
+func (s *GoScript) V_GetMemberLine(member StringName) int32 {
+	return -1
+}
+
+func (s *GoScript) V_GetConstants() Dictionary {
+	return NewDictionary()
+}
+
+func (s *GoScript) V_GetMembers() Array {
+	ret := NewArray()
+	info, ok := s.behaviourInfo()
+	if !ok {
+		return ret
+	}
+	for _, p := range info.properties {
+		sn := NewStringNameWithUtf8Chars(p.name)
+		v := NewVariantStringName(sn)
+		ret.Append(v)
+		v.Destroy()
+		sn.Destroy()
+	}
+	return ret
+}
+
+func (s *GoScript) V_IsPlaceholderFallbackEnabled() bool {
+	return false
+}
+
+func (s *GoScript) V_GetRpcConfig() Variant {
+	return NewVariantNil()
+}
+
+func (s *GoScript) updatePlaceholders() {
+	s.mu.Lock()
+	placeholders := make([]GDExtensionScriptInstancePtr, 0, len(s.placeholders))
+	for p := range s.placeholders {
+		placeholders = append(placeholders, p)
+	}
+	s.mu.Unlock()
+	for _, p := range placeholders {
+		s.updatePlaceholder(p)
+	}
+}
+
+// updatePlaceholder reports the properties of the behaviour and their
+// default values to a placeholder instance.
+func (s *GoScript) updatePlaceholder(placeholder GDExtensionScriptInstancePtr) {
+	properties := s.V_GetScriptPropertyList()
+	defer properties.Destroy()
+	values := NewDictionary()
+	defer values.Destroy()
+	if info, ok := s.behaviourInfo(); ok {
+		for name, v := range info.propertyDefaults() {
+			values.SetKeyed(name, v)
+			v.Destroy()
+		}
+	}
+	CallFunc_GDExtensionInterfacePlaceHolderScriptInstanceUpdate(
+		placeholder,
+		properties.NativeConstPtr(),
+		values.NativeConstPtr(),
+	)
+}
+
+func NewGoScriptFromOwnerObject(owner *GodotObject) GDClass {
+	obj := &GoScript{}
+	obj.SetGodotObjectOwner(owner)
+	return obj
+}
+
+// propertyDictionary converts a property into the Dictionary form used by
+// the scripting API.
+func propertyDictionary(vt GDExtensionVariantType, name string, po PropertyOptions) Dictionary {
+	d := NewDictionary()
+	setKeyedInt64(&d, "type", int64(vt))
+	setKeyedString(&d, "name", name)
+	setKeyedString(&d, "class_name", po.ClassName)
+	setKeyedInt64(&d, "hint", int64(po.Hint))
+	setKeyedString(&d, "hint_string", po.HintString)
+	setKeyedInt64(&d, "usage", int64(po.Usage))
+	return d
+}
+
+// methodDictionary converts a method into the Dictionary form used by the
+// scripting API.
+func methodDictionary(m behaviourMethod) Dictionary {
+	d := NewDictionary()
+	setKeyedString(&d, "name", m.gdName)
+	args := NewArray()
+	defer args.Destroy()
+	for i, vt := range m.argTypes {
+		arg := propertyDictionary(vt, argumentName(i), argumentPropertyOptions(vt))
+		v := NewVariantDictionary(arg)
+		args.Append(v)
+		v.Destroy()
+		arg.Destroy()
+	}
+	vArgs := NewVariantArray(args)
+	defer vArgs.Destroy()
+	d.SetKeyed("args", vArgs)
+	ret := propertyDictionary(GDEXTENSION_VARIANT_TYPE_NIL, "", argumentPropertyOptions(GDEXTENSION_VARIANT_TYPE_NIL))
+	if m.hasReturn {
+		ret.Destroy()
+		ret = propertyDictionary(m.returnType, "", argumentPropertyOptions(m.returnType))
+	} else {
+		setKeyedInt64(&ret, "usage", int64(PROPERTY_USAGE_DEFAULT))
+	}
+	defer ret.Destroy()
+	vRet := NewVariantDictionary(ret)
+	defer vRet.Destroy()
+	d.SetKeyed("return", vRet)
+	setKeyedInt64(&d, "flags", int64(m.methodFlags()))
+	return d
+}
+
+func setKeyedString(d *Dictionary, key, value string) {
+	v := NewVariantGoString(value)
+	defer v.Destroy()
+	d.SetKeyed(key, v)
+}
+
+func setKeyedInt64(d *Dictionary, key string, value int64) {
+	v := NewVariantInt64(value)
+	defer v.Destroy()
+	d.SetKeyed(key, v)
+}
+
+// argumentName names the arguments of behaviour methods as Go does not keep
+// parameter names.
+func argumentName(i int) string {
+	return fmt.Sprintf("arg%d", i)
+}
@@ -0,0 +1,106 @@
+#include <godot/gdextension_interface.h>
+#include "script_instance.h"
+#include "stacktrace.h"
+
+extern GDExtensionBool GoCallback_ScriptInstanceSet(GDExtensionScriptInstanceDataPtr p_instance, GDExtensionConstStringNamePtr p_name, GDExtensionConstVariantPtr p_value);
+extern GDExtensionBool GoCallback_ScriptInstanceGet(GDExtensionScriptInstanceDataPtr p_instance, GDExtensionConstStringNamePtr p_name, GDExtensionVariantPtr r_ret);
+extern const GDExtensionPropertyInfo *GoCallback_ScriptInstanceGetPropertyList(GDExtensionScriptInstanceDataPtr p_instance, uint32_t *r_count);
+extern void GoCallback_ScriptInstanceFreePropertyList(GDExtensionScriptInstanceDataPtr p_instance, const GDExtensionPropertyInfo *p_list, uint32_t p_count);
+extern GDExtensionObjectPtr GoCallback_ScriptInstanceGetOwner(GDExtensionScriptInstanceDataPtr p_instance);
+extern const GDExtensionMethodInfo *GoCallback_ScriptInstanceGetMethodList(GDExtensionScriptInstanceDataPtr p_instance, uint32_t *r_count);
+extern void GoCallback_ScriptInstanceFreeMethodList(GDExtensionScriptInstanceDataPtr p_instance, const GDExtensionMethodInfo *p_list, uint32_t p_count);
+extern GDExtensionVariantType GoCallback_ScriptInstanceGetPropertyType(GDExtensionScriptInstanceDataPtr p_instance, GDExtensionConstStringNamePtr p_name, GDExtensionBool *r_is_valid);
+extern GDExtensionBool GoCallback_ScriptInstanceHasMethod(GDExtensionScriptInstanceDataPtr p_instance, GDExtensionConstStringNamePtr p_name);
+extern GDExtensionInt GoCallback_ScriptInstanceGetMethodArgumentCount(GDExtensionScriptInstanceDataPtr p_instance, GDExtensionConstStringNamePtr p_name, GDExtensionBool *r_is_valid);
+extern void GoCallback_ScriptInstanceCall(GDExtensionScriptInstanceDataPtr p_self, GDExtensionConstStringNamePtr p_method, const GDExtensionConstVariantPtr *p_args, GDExtensionInt p_argument_count, GDExtensionVariantPtr r_return, GDExtensionCallError *r_error);
+extern void GoCallback_ScriptInstanceNotification(GDExtensionScriptInstanceDataPtr p_instance, int32_t p_what, GDExtensionBool p_reversed);
+extern void GoCallback_ScriptInstanceToString(GDExtensionScriptInstanceDataPtr p_instance, GDExtensionBool *r_is_valid, GDExtensionStringPtr r_out);
+extern GDExtensionObjectPtr GoCallback_ScriptInstanceGetScript(GDExtensionScriptInstanceDataPtr p_instance);
+extern GDExtensionBool GoCallback_ScriptInstanceIsPlaceholder(GDExtensionScriptInstanceDataPtr p_instance);
+extern GDExtensionScriptLanguagePtr GoCallback_ScriptInstanceGetLanguage(GDExtensionScriptInstanceDataPtr p_instance);
+extern void GoCallback_ScriptInstanceFree(GDExtensionScriptInstanceDataPtr p_instance);
+
+GDExtensionBool cgo_script_instance_set(GDExtensionScriptInstanceDataPtr p_instance, GDExtensionConstStringNamePtr p_name, GDExtensionConstVariantPtr p_value) {
+	printStacktrace();
+	return GoCallback_ScriptInstanceSet(p_instance, p_name, p_value);
+}
+
+GDExtensionBool cgo_script_instance_get(GDExtensionScriptInstanceDataPtr p_instance, GDExtensionConstStringNamePtr p_name, GDExtensionVariantPtr r_ret) {
+	printStacktrace();
+	return GoCallback_ScriptInstanceGet(p_instance, p_name, r_ret);
+}
+
+const GDExtensionPropertyInfo *cgo_script_instance_get_property_list(GDExtensionScriptInstanceDataPtr p_instance, uint32_t *r_count) {
+	printStacktrace();
+	return GoCallback_ScriptInstanceGetPropertyList(p_instance, r_count);
+}
+
+void cgo_script_instance_free_property_list(GDExtensionScriptInstanceDataPtr p_instance, const GDExtensionPropertyInfo *p_list, uint32_t p_count) {
+	printStacktrace();
+	GoCallback_ScriptInstanceFreePropertyList(p_instance, p_list, p_count);
+}
+
+GDExtensionObjectPtr cgo_script_instance_get_owner(GDExtensionScriptInstanceDataPtr p_instance) {
+	printStacktrace();
+	return GoCallback_ScriptInstanceGetOwner(p_instance);
+}
+
+const GDExtensionMethodInfo *cgo_script_instance_get_method_list(GDExtensionScriptInstanceDataPtr p_instance, uint32_t *r_count) {
+	printStacktrace();
+	return GoCallback_ScriptInstanceGetMethodList(p_instance, r_count);
+}
+
+void cgo_script_instance_free_method_list(GDExtensionScriptInstanceDataPtr p_instance, const GDExtensionMethodInfo *p_list, uint32_t p_count) {
+	printStacktrace();
+	GoCallback_ScriptInstanceFreeMethodList(p_instance, p_list, p_count);
+}
+
+GDExtensionVariantType cgo_script_instance_get_property_type(GDExtensionScriptInstanceDataPtr p_instance, GDExtensionConstStringNamePtr p_name, GDExtensionBool *r_is_valid) {
+	printStacktrace();
+	return GoCallback_ScriptInstanceGetPropertyType(p_instance, p_name, r_is_valid);
+}
+
+GDExtensionBool cgo_script_instance_has_method(GDExtensionScriptInstanceDataPtr p_instance, GDExtensionConstStringNamePtr p_name) {
+	printStacktrace();
+	return GoCallback_ScriptInstanceHasMethod(p_instance, p_name);
+}
+
+GDExtensionInt cgo_script_instance_get_method_argument_count(GDExtensionScriptInstanceDataPtr p_instance, GDExtensionConstStringNamePtr p_name, GDExtensionBool *r_is_valid) {
+	printStacktrace();
+	return GoCallback_ScriptInstanceGetMethodArgumentCount(p_instance, p_name, r_is_valid);
+}
+
+void cgo_script_instance_call(GDExtensionScriptInstanceDataPtr p_self, GDExtensionConstStringNamePtr p_method, const GDExtensionConstVariantPtr *p_args, GDExtensionInt p_argument_count, GDExtensionVariantPtr r_return, GDExtensionCallError *r_error) {
+	printStacktrace();
+	GoCallback_ScriptInstanceCall(p_self, p_method, p_args, p_argument_count, r_return, r_error);
+}
+
+void cgo_script_instance_notification(GDExtensionScriptInstanceDataPtr p_instance, int32_t p_what, GDExtensionBool p_reversed) {
+	printStacktrace();
+	GoCallback_ScriptInstanceNotification(p_instance, p_what, p_reversed);
+}
+
+void cgo_script_instance_to_string(GDExtensionScriptInstanceDataPtr p_instance, GDExtensionBool *r_is_valid, GDExtensionStringPtr r_out) {
+	printStacktrace();
+	GoCallback_ScriptInstanceToString(p_instance, r_is_valid, r_out);
+}
+
+GDExtensionObjectPtr cgo_script_instance_get_script(GDExtensionScriptInstanceDataPtr p_instance) {
+	printStacktrace();
+	return GoCallback_ScriptInstanceGetScript(p_instance);
+}
+
+GDExtensionBool cgo_script_instance_is_placeholder(GDExtensionScriptInstanceDataPtr p_instance) {
+	printStacktrace();
+	return GoCallback_ScriptInstanceIsPlaceholder(p_instance);
+}
+
+GDExtensionScriptLanguagePtr cgo_script_instance_get_language(GDExtensionScriptInstanceDataPtr p_instance) {
+	printStacktrace();
+	return GoCallback_ScriptInstanceGetLanguage(p_instance);
+}
+
+void cgo_script_instance_free(GDExtensionScriptInstanceDataPtr p_instance) {
+	printStacktrace();
+	GoCallback_ScriptInstanceFree(p_instance);
+}
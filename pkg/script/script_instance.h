@@ -0,0 +1,24 @@
+#ifndef CGO_GODOT_GO_SCRIPT_INSTANCE_H
+#define CGO_GODOT_GO_SCRIPT_INSTANCE_H
+
+#include <godot/gdextension_interface.h>
+
+GDExtensionBool cgo_script_instance_set(GDExtensionScriptInstanceDataPtr p_instance, GDExtensionConstStringNamePtr p_name, GDExtensionConstVariantPtr p_value);
+GDExtensionBool cgo_script_instance_get(GDExtensionScriptInstanceDataPtr p_instance, GDExtensionConstStringNamePtr p_name, GDExtensionVariantPtr r_ret);
+const GDExtensionPropertyInfo *cgo_script_instance_get_property_list(GDExtensionScriptInstanceDataPtr p_instance, uint32_t *r_count);
+void cgo_script_instance_free_property_list(GDExtensionScriptInstanceDataPtr p_instance, const GDExtensionPropertyInfo *p_list, uint32_t p_count);
+GDExtensionObjectPtr cgo_script_instance_get_owner(GDExtensionScriptInstanceDataPtr p_instance);
+const GDExtensionMethodInfo *cgo_script_instance_get_method_list(GDExtensionScriptInstanceDataPtr p_instance, uint32_t *r_count);
+void cgo_script_instance_free_method_list(GDExtensionScriptInstanceDataPtr p_instance, const GDExtensionMethodInfo *p_list, uint32_t p_count);
+GDExtensionVariantType cgo_script_instance_get_property_type(GDExtensionScriptInstanceDataPtr p_instance, GDExtensionConstStringNamePtr p_name, GDExtensionBool *r_is_valid);
+GDExtensionBool cgo_script_instance_has_method(GDExtensionScriptInstanceDataPtr p_instance, GDExtensionConstStringNamePtr p_name);
+GDExtensionInt cgo_script_instance_get_method_argument_count(GDExtensionScriptInstanceDataPtr p_instance, GDExtensionConstStringNamePtr p_name, GDExtensionBool *r_is_valid);
+void cgo_script_instance_call(GDExtensionScriptInstanceDataPtr p_self, GDExtensionConstStringNamePtr p_method, const GDExtensionConstVariantPtr *p_args, GDExtensionInt p_argument_count, GDExtensionVariantPtr r_return, GDExtensionCallError *r_error);
+void cgo_script_instance_notification(GDExtensionScriptInstanceDataPtr p_instance, int32_t p_what, GDExtensionBool p_reversed);
+void cgo_script_instance_to_string(GDExtensionScriptInstanceDataPtr p_instance, GDExtensionBool *r_is_valid, GDExtensionStringPtr r_out);
+GDExtensionObjectPtr cgo_script_instance_get_script(GDExtensionScriptInstanceDataPtr p_instance);
+GDExtensionBool cgo_script_instance_is_placeholder(GDExtensionScriptInstanceDataPtr p_instance);
+GDExtensionScriptLanguagePtr cgo_script_instance_get_language(GDExtensionScriptInstanceDataPtr p_instance);
+void cgo_script_instance_free(GDExtensionScriptInstanceDataPtr p_instance);
+
+#endif
@@ -3,8 +3,24 @@ package util
 import (
 	"reflect"
 	"strings"
+	"unicode"
+
+	"github.com/iancoleman/strcase"
 )
 
+// SnakeCase converts a Go identifier into a Godot name; digits stay attached
+// to the preceding word (TestStatic2 becomes test_static2).
+func SnakeCase(v string) string {
+	var sb strings.Builder
+	for _, w := range strings.Split(strcase.ToSnake(v), "_") {
+		if sb.Len() > 0 && !(len(w) > 0 && unicode.IsDigit(rune(w[0]))) {
+			sb.WriteString("_")
+		}
+		sb.WriteString(w)
+	}
+	return sb.String()
+}
+
 func ReflectValueSliceToString(values []reflect.Value) string {
 	var sb strings.Builder
 	sb.WriteString("(")
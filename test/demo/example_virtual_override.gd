@@ -0,0 +1,4 @@
+extends ExampleVirtual
+
+func _on_hit(damage: int) -> int:
+	return damage * 2
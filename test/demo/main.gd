@@ -9,6 +9,24 @@ class TestClass:
 func _ready():
 	var example: Example = $Example
 	test_suite(1, example)
+
+	# Main thread dispatch from goroutines.
+	example.test_run_on_main()
+	for i in 60:
+		if example.test_ran_on_main():
+			break
+		await get_tree().process_frame
+	assert_equal(example.test_ran_on_main(), true)
+
+	# Canceled awaits disconnect their handler on the main thread.
+	var connections = example.get_signal_connection_list("custom_signal").size()
+	assert_equal(example.test_await_context_canceled(), true)
+	assert_equal(example.get_signal_connection_list("custom_signal").size(), connections + 1)
+	for i in 60:
+		if example.get_signal_connection_list("custom_signal").size() == connections:
+			break
+		await get_tree().process_frame
+	assert_equal(example.get_signal_connection_list("custom_signal").size(), connections)
 	# example.group_subgroup_custom_position = Vector2(0, 0)
 	# custom_signal_emitted = null
 	# var t = get_tree()
@@ -28,19 +46,40 @@ func test_suite(i: int, example: Example):
 	# It appears there's a bug with instance ids :-(
 	#assert_equal($Example/ExampleMin.to_string(), 'ExampleMin:[Wrapped:%s]' % $Example/ExampleMin.get_instance_id())
 
-	# godot-go will probably not support static methods since they don't exist in go
 	# Call static methods.
-	# assert_equal(Example.test_static(9, 100), 109);
+	assert_equal(Example.test_static(9, 100), 109);
 	# It's void and static, so all we know is that it didn't crash.
-	# Example.test_static2()
+	Example.test_static2()
+	assert_equal(Example.test_static_join("hello", "world"), "hello world")
+
+	# Error returns.
+	assert_equal(example.test_error_return(false), OK)
+	assert_equal(example.test_error_return(true), ERR_FILE_NOT_FOUND)
+	assert_equal(example.test_value_and_error(21), 42)
+	assert_equal(example.call("test_value_and_error", 21), 42)
+	# callv prints the call error instead of aborting the test
+	assert_equal(example.callv("test_value_and_error", [-1]), null)
 
 	# Property list.
 	example.property_from_list = Vector3(100, 200, 300)
 	assert_equal(example.property_from_list, Vector3(100, 200, 300))
+	assert_equal(example.property_can_revert("property_from_list"), true)
+	assert_equal(example.property_get_revert("property_from_list"), Vector3(42, 42, 42))
+
+	# Property revert to a registered default.
+	example.speed = 50
+	assert_equal(example.property_can_revert("speed"), true)
+	assert_equal(example.property_get_revert("speed"), 10)
+	example.speed = 10
+	assert_equal(example.property_can_revert("speed"), false)
+
 	var prop_list = example.get_property_list()
 	for prop_info in prop_list:
 		if prop_info['name'] == 'mouse_filter':
 			assert_equal(prop_info['usage'], PROPERTY_USAGE_NO_EDITOR)
+		if prop_info['name'] == 'speed':
+			assert_equal(prop_info['hint'], PROPERTY_HINT_RANGE)
+			assert_equal(prop_info['hint_string'], "0,100,1,or_greater")
 
 	# Call simple methods.
 	example.simple_func()
@@ -49,12 +88,12 @@ func test_suite(i: int, example: Example):
 	assert_equal(custom_signal_emitted, ['simple_const_func', 4])
 
 	# Pass custom reference.
-	# assert_equal(example.custom_ref_func(null), -1)
-	# var ref1 = ExampleRef.new()
-	# ref1.id = 27
-	# assert_equal(example.custom_ref_func(ref1), 27)
-	# ref1.id += 1;
-	# assert_equal(example.custom_const_ref_func(ref1), 28)
+	assert_equal(example.custom_ref_func(null), -1)
+	var ref1 = ExampleRef.new()
+	ref1.group_subgroup_id = 27
+	assert_equal(example.custom_ref_func(ref1), 27)
+	ref1.group_subgroup_id += 1;
+	assert_equal(example.custom_const_ref_func(ref1), 28)
 
 	# Pass core reference.
 	assert_equal(example.image_ref_func(null), "invalid")
@@ -68,15 +107,18 @@ func test_suite(i: int, example: Example):
 	assert_equal(example.return_something_const(), get_viewport())
 	var null_ref = example.return_empty_ref()
 	assert_equal(null_ref, null)
-	# var ret_ref = example.return_extended_ref()
-	# assert_not_equal(ret_ref.get_instance_id(), 0)
-	# assert_equal(ret_ref.get_id(), 0)
+	var ret_ref = example.return_extended_ref()
+	assert_not_equal(ret_ref.get_instance_id(), 0)
+	assert_equal(ret_ref.get_id(), 0)
 	assert_equal(example.get_v4(), Vector4(1.2, 3.4, 5.6, 7.8))
 	assert_equal(example.test_node_argument(example), example)
 
 	# VarArg method calls.
-	# var var_ref = ExampleRef.new()
-	# assert_not_equal(example.extended_ref_checks(var_ref).get_instance_id(), var_ref.get_instance_id())
+	var var_ref = ExampleRef.new()
+	var_ref.group_subgroup_id = 7
+	var checked_ref = example.extended_ref_checks(var_ref)
+	assert_not_equal(checked_ref.get_instance_id(), var_ref.get_instance_id())
+	assert_equal(checked_ref.get_id(), 7)
 	assert_equal(example.varargs_func("some", "arguments", "to", "test"), 4)
 	assert_equal(example.varargs_func("some"), 1)
 	assert_equal(example.varargs_func_nv("some", "arguments", "to", "test"), 46)
@@ -96,9 +138,94 @@ func test_suite(i: int, example: Example):
 	print("array: ", array)
 	assert_equal(example.test_tarray_arg(array), 6)
 
+	# Go slices and maps
+	assert_equal(example.test_slice_sum(PackedInt64Array([1, 2, 3])), 6)
+	assert_equal(example.test_slice_sum([4, 5]), 9)
+	assert_equal(example.test_slice_reverse(PackedStringArray(["a", "b", "c"])), PackedStringArray(["c", "b", "a"]))
+	assert_equal(example.test_slice_bytes(PackedByteArray([1, 2, 255])), PackedByteArray([2, 3, 0]))
+	var grid = example.test_slice_grid(2)
+	assert_equal(grid, [Vector2i(0, 0), Vector2i(1, 0), Vector2i(0, 1), Vector2i(1, 1)])
+	assert_equal(grid.get_typed_builtin(), TYPE_VECTOR2I)
+	assert_equal(example.test_map_count(["go", "godot", "go"]), {"go": 2, "godot": 1})
+	assert_equal(example.test_map_sum({"a": 1, "b": 2}), 3)
+	for method in example.get_method_list():
+		if method["name"] == "test_slice_grid":
+			assert_equal(method["return"]["type"], TYPE_ARRAY)
+			assert_equal(method["return"]["hint"], PROPERTY_HINT_ARRAY_TYPE)
+			assert_equal(method["return"]["hint_string"], "Vector2i")
+		elif method["name"] == "test_slice_sum":
+			assert_equal(method["args"][0]["type"], TYPE_PACKED_INT64_ARRAY)
+
+	# Go structs
+	assert_equal(example.test_struct_hit(7), {"position": Vector3(1, 2, 3), "damage": 7, "source": {"name": "go"}})
+	assert_equal(example.test_struct_damage({"damage": 4, "source": {"critical": true}, "unknown": 1}), 8)
+	assert_equal(example.test_struct_damage({}), 0)
+	assert_equal(example.test_struct_hits([{"damage": 1}, {"damage": 2, "tags": ["a"]}]), 3)
+
+	# Iterators
+	assert_equal(example.test_iter_dictionary({"a": 1, "b": "x"}), "a=1;b=x;")
+	assert_equal(example.test_iter_variant(5), 5)
+	assert_equal(example.test_iter_variant("godot"), 5)
+	assert_equal(example.test_iter_variant([1, 2, 3]), 3)
+	assert_equal(example.test_iter_variant(Vector2i(2, 6)), 4)
+
+	# Variant hashing, equality and keys
+	assert_equal(example.test_variant_hash("godot"), hash("godot"))
+	assert_equal(example.test_variant_hash([1, {"a": 2}]), hash([1, {"a": 2}]))
+	assert_equal(example.test_variant_equal(1, 1.0), true)
+	assert_equal(example.test_variant_equal("a", &"a"), true)
+	assert_equal(example.test_variant_equal([1, 2], [1, 3]), false)
+	assert_equal(example.test_variant_hash_compare(1, 1.0), false)
+	assert_equal(example.test_variant_hash_compare(NAN, NAN), true)
+	assert_equal(example.test_variant_keys(["a", &"a", "a", 1, 1.0, [1, 2], [1, 2], {"x": 1, "y": 2}, {"y": 2, "x": 1}, NAN, NAN, self, self]), 8)
+
+	# TypedArray and TypedDictionary
+	var typed_range = example.test_typed_array_range(3)
+	assert_equal(typed_range, [0, 1, 2])
+	assert_equal(typed_range.get_typed_builtin(), TYPE_INT)
+	assert_equal(example.test_typed_array_sum([1, 2, 3]), 6)
+	assert_equal(example.test_typed_array_first([example]), example)
+	var typed_counts = example.test_typed_dictionary_count(["go", "godot", "go"])
+	assert_equal(typed_counts, {"go": 2, "godot": 1})
+	assert_equal(typed_counts.get_typed_key_builtin(), TYPE_STRING)
+	assert_equal(typed_counts.get_typed_value_builtin(), TYPE_INT)
+	assert_equal(example.test_typed_dictionary_get({"a": 1}, "a"), 1)
+	assert_equal(example.test_typed_dictionary_get({"a": 1}, "b"), -1)
+	for method in example.get_method_list():
+		if method["name"] == "test_typed_array_first":
+			assert_equal(method["args"][0]["hint"], PROPERTY_HINT_ARRAY_TYPE)
+			assert_equal(method["args"][0]["hint_string"], "Example")
+		elif method["name"] == "test_typed_dictionary_count":
+			assert_equal(method["return"]["hint"], PROPERTY_HINT_DICTIONARY_TYPE)
+			assert_equal(method["return"]["hint_string"], "String;int")
+
+	# WorkerThreadPool tasks running Go funcs.
+	WorkerThreadPool.wait_for_task_completion(example.test_submit_task(100))
+	assert_equal(example.test_task_sum(), 100)
+	WorkerThreadPool.wait_for_group_task_completion(example.test_submit_group_task(10))
+	assert_equal(example.test_task_sum(), 145)
+
 	example.callable_bind()
 	assert_equal(custom_signal_emitted, ["bound", 11])
 
+	# Go funcs as Callables
+	var go_callable: Callable = example.test_callable_from_func()
+	assert_equal(go_callable.is_custom(), true)
+	assert_equal(go_callable.call(2, 1), true)
+	assert_equal(go_callable.get_argument_count(), 2)
+	assert_equal(go_callable.hash(), go_callable.hash())
+	assert_equal(go_callable == example.test_callable_from_func(), false)
+	assert_equal(str(go_callable).begins_with("GoCallable("), true)
+	var sorted := [1, 3, 2]
+	sorted.sort_custom(go_callable)
+	assert_equal(sorted, [3, 2, 1])
+	var variadic_callable: Callable = example.test_callable_from_variadic_func()
+	assert_equal(variadic_callable.call(), 0)
+	assert_equal(variadic_callable.call("a", 1, Vector2(1, 2)), 3)
+
+	# Await signals from Go
+	assert_equal(example.test_await_signal(77), 77)
+
 	# String += operator
 	assert_equal(example.test_string_ops(), "ABCĎE")
 
@@ -268,5 +395,146 @@ func test_suite(i: int, example: Example):
 
 	assert_equal(example.test_parent_is_nil(), null)
 
+	# Notifications.
+	assert_equal(example.test_ready_notified(), true)
+
+	# Automatically registered class.
+	var auto = ExampleAuto.new()
+	auto.stats_health = 50
+	assert_equal(auto.get_health(), 50)
+	assert_equal(ExampleAuto.sum(2, 3), 5)
+	var damaged = []
+	auto.damaged.connect(func(amount): damaged.append(amount))
+	auto.damage(20)
+	assert_equal(damaged, [20])
+	assert_equal(auto.stats_health, 30)
+	var healed = []
+	auto.healed.connect(func(amount): healed.append(amount))
+	auto.heal(5)
+	assert_equal(healed, [5])
+	assert_equal(auto.stats_health, 35)
+	# Exported fields.
+	assert_equal(auto.movement_speed, 0.0)
+	auto.movement_speed = 2.5
+	assert_equal(auto.get_movement_speed(), 2.5)
+	assert_equal(auto.move(2.0), 5.0)
+	for p in auto.get_property_list():
+		if p["name"] == "movement_speed":
+			assert_equal(p["type"], TYPE_FLOAT)
+			assert_equal(p["hint"], PROPERTY_HINT_RANGE)
+			assert_equal(p["hint_string"], "0,10,0.5")
+			assert_equal(p["usage"] & PROPERTY_USAGE_STORAGE, PROPERTY_USAGE_STORAGE)
+	# Exported fields holding engine values.
+	assert_equal(auto.title, "")
+	auto.title = "auto"
+	auto.title += "!"
+	assert_equal(auto.title, "auto!")
+	assert_equal(auto.items, [])
+	var items = [1, "two"]
+	auto.items = items
+	items.append(3)
+	assert_equal(auto.items, [1, "two", 3])
+	auto.items = [4]
+	assert_equal(auto.items, [4])
+	assert_equal(items, [1, "two", 3])
+	assert_equal(auto.payload, null)
+	auto.payload = {"a": 1}
+	assert_equal(auto.payload, {"a": 1})
+	auto.payload = 7
+	assert_equal(auto.payload, 7)
+	add_child(auto)
+	assert_equal(auto.label, "ready")
+	# Typed signals connected from Go.
+	auto.heal(4)
+	assert_equal(healed, [5, 4])
+	assert_equal(auto.get_healed_total(), 4)
+	var scanned = []
+	auto.scanned.connect(func(node, values, flags): scanned.append([node, values, flags]))
+	auto.scan()
+	assert_equal(scanned, [[auto, PackedInt64Array([39, 4]), [true]]])
+	assert_equal(typeof(scanned[0][1]), TYPE_PACKED_INT64_ARRAY)
+	assert_equal(scanned[0][2].get_typed_builtin(), TYPE_BOOL)
+	for s in auto.get_signal_list():
+		if s["name"] == "scanned":
+			assert_equal(s["args"][0]["class_name"], &"Node")
+			assert_equal(s["args"][1]["type"], TYPE_PACKED_INT64_ARRAY)
+			assert_equal(s["args"][2]["hint"], PROPERTY_HINT_ARRAY_TYPE)
+			assert_equal(s["args"][2]["hint_string"], "bool")
+	auto.stop_heal_tracking()
+	auto.heal(1)
+	assert_equal(healed, [5, 4, 1])
+	assert_equal(auto.get_healed_total(), 4)
+	assert_equal(auto.has_method("_serialize_state"), false)
+	# Hot reload: a new Go instance gets the label back but not the total.
+	example.test_reload_example_auto()
+	assert_equal(auto.label, "ready")
+	assert_equal(auto.get_healed_total(), 0)
+	assert_equal(auto.has_meta("_godot_go_hot_reload_state"), false)
+	auto.queue_free()
+
+	# Class registration options.
+	assert_equal(ClassDB.can_instantiate("ExampleAbstractBase"), false)
+	var concrete = ExampleConcrete.new()
+	assert_equal(concrete is ExampleAbstractBase, true)
+	assert_equal(concrete.get_base_value(), 21)
+	assert_equal(concrete.get_concrete_value(), 42)
+	concrete.free()
+	var internal_class = example.test_get_internal_class()
+	assert_equal(internal_class.get_class(), "ExampleInternal")
+	assert_equal(internal_class.get_the_answer(), 42)
+	var runtime = ExampleRuntime.new()
+	add_child(runtime)
+	assert_equal(runtime.is_processed(), true)
+	runtime.queue_free()
+
+	# Script-overridable virtual methods.
+	var plain_virtual = ExampleVirtual.new()
+	assert_equal(plain_virtual.hit(5), 5)
+	plain_virtual.free()
+	var scripted_virtual = preload("res://example_virtual_override.gd").new()
+	assert_equal(scripted_virtual is ExampleVirtual, true)
+	assert_equal(scripted_virtual.hit(5), 10)
+	scripted_virtual.free()
+
+	# Per-instance property lists.
+	var slots = ExampleSlots.new()
+	var slot_names = func():
+		return slots.get_property_list().map(func(p): return p["name"]).filter(func(n): return n.begins_with("slot_"))
+	assert_equal(slot_names.call(), [])
+	slots.slot_count = 2
+	assert_equal(slot_names.call(), ["slot_0", "slot_1"])
+	slots.set("slot_1", 7)
+	assert_equal(slots.get("slot_1"), 7)
+	slots.slot_count = 3
+	assert_equal(slot_names.call(), ["slot_0", "slot_1", "slot_2"])
+	assert_equal(slots.get("slot_1"), 7)
+	slots.slot_count = -1
+	assert_equal(slots.slot_count, 0)
+	assert_equal(slot_names.call(), [])
+	slots.slot_count = 100
+	assert_equal(slots.slot_count, 8)
+	slots.free()
+
+	# Go scripts.
+	var go_script = GoScript.new()
+	go_script.behaviour = "Spinner"
+	var spinner = Node.new()
+	spinner.set_script(go_script)
+	assert_equal(spinner.get_script(), go_script)
+	assert_equal(spinner.has_method("spin"), true)
+	assert_equal(spinner.speed, 90.0)
+	spinner.speed = 180.0
+	assert_equal(spinner.get("speed"), 180.0)
+	assert_equal(spinner.spin(2), 2)
+	assert_equal(spinner.spin(3), 5)
+	assert_equal(spinner.turns(), 5)
+	assert_equal(str(spinner), "Spinner(spinner)")
+	var spinner_properties = spinner.get_property_list().map(func(p): return p.name)
+	assert_equal(spinner_properties.has("speed"), true)
+	assert_equal(spinner_properties.has("label"), true)
+	var spinner_methods = go_script.get_script_method_list().map(func(m): return m.name)
+	assert_equal(spinner_methods.has("spin"), true)
+	spinner.free()
+
 func _on_Example_custom_signal(signal_name, value):
 	custom_signal_emitted = [signal_name, value]
@@ -0,0 +1,817 @@
+// Code generated by godot-go bindgen. DO NOT EDIT.
+
+package pkg
+
+import (
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/core"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+)
+
+func init() {
+	RegisterMethodTrampoline[*Example]("CallableBind", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*Example).CallableBind()
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*Example).CallableBind()
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("DefArgs", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).DefArgs(
+				Int32Encoder.DecodeTypePtr(args[0]),
+				Int32Encoder.DecodeTypePtr(args[1]),
+			)
+			Int32Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).DefArgs(
+				Int32Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+				Int32Encoder.DecodeVariantPtr(args[1].NativeConstPtr()),
+			)
+			Int32Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("EmitCustomSignal", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*Example).EmitCustomSignal(
+				GoStringUtf8Encoder.DecodeTypePtr(args[0]),
+				Int64Encoder.DecodeTypePtr(args[1]),
+			)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*Example).EmitCustomSignal(
+				GoStringUtf8Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+				Int64Encoder.DecodeVariantPtr(args[1].NativeConstPtr()),
+			)
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("GetCustomPosition", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).GetCustomPosition()
+			Vector2Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).GetCustomPosition()
+			Vector2Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("GetPropertyFromList", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).GetPropertyFromList()
+			Vector3Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).GetPropertyFromList()
+			Vector3Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("GetSpeed", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).GetSpeed()
+			Int64Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).GetSpeed()
+			Int64Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("GetV4", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).GetV4()
+			Vector4Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).GetV4()
+			Vector4Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("ReturnSomething", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).ReturnSomething(
+				GoStringUtf8Encoder.DecodeTypePtr(args[0]),
+				Float32Encoder.DecodeTypePtr(args[1]),
+				Float64Encoder.DecodeTypePtr(args[2]),
+				IntEncoder.DecodeTypePtr(args[3]),
+				Int8Encoder.DecodeTypePtr(args[4]),
+				Int16Encoder.DecodeTypePtr(args[5]),
+				Int32Encoder.DecodeTypePtr(args[6]),
+				Int64Encoder.DecodeTypePtr(args[7]),
+			)
+			GoStringUtf8Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).ReturnSomething(
+				GoStringUtf8Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+				Float32Encoder.DecodeVariantPtr(args[1].NativeConstPtr()),
+				Float64Encoder.DecodeVariantPtr(args[2].NativeConstPtr()),
+				IntEncoder.DecodeVariantPtr(args[3].NativeConstPtr()),
+				Int8Encoder.DecodeVariantPtr(args[4].NativeConstPtr()),
+				Int16Encoder.DecodeVariantPtr(args[5].NativeConstPtr()),
+				Int32Encoder.DecodeVariantPtr(args[6].NativeConstPtr()),
+				Int64Encoder.DecodeVariantPtr(args[7].NativeConstPtr()),
+			)
+			GoStringUtf8Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("SetCustomPosition", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*Example).SetCustomPosition(
+				Vector2Encoder.DecodeTypePtr(args[0]),
+			)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*Example).SetCustomPosition(
+				Vector2Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("SetPropertyFromList", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*Example).SetPropertyFromList(
+				Vector3Encoder.DecodeTypePtr(args[0]),
+			)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*Example).SetPropertyFromList(
+				Vector3Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("SetSpeed", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*Example).SetSpeed(
+				Int64Encoder.DecodeTypePtr(args[0]),
+			)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*Example).SetSpeed(
+				Int64Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("SimpleConstFunc", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*Example).SimpleConstFunc(
+				Int64Encoder.DecodeTypePtr(args[0]),
+			)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*Example).SimpleConstFunc(
+				Int64Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("SimpleFunc", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*Example).SimpleFunc()
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*Example).SimpleFunc()
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestArray", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestArray()
+			ArrayEncoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestArray()
+			ArrayEncoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestAwaitContextCanceled", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestAwaitContextCanceled()
+			BoolEncoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestAwaitContextCanceled()
+			BoolEncoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestAwaitSignal", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestAwaitSignal(
+				Int64Encoder.DecodeTypePtr(args[0]),
+			)
+			Int64Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestAwaitSignal(
+				Int64Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			Int64Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestCallableFromFunc", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestCallableFromFunc()
+			CallableEncoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestCallableFromFunc()
+			CallableEncoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestCallableFromVariadicFunc", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestCallableFromVariadicFunc()
+			CallableEncoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestCallableFromVariadicFunc()
+			CallableEncoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestDictionary", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestDictionary()
+			DictionaryEncoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestDictionary()
+			DictionaryEncoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestIterDictionary", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestIterDictionary(
+				DictionaryEncoder.DecodeTypePtr(args[0]),
+			)
+			GoStringUtf8Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestIterDictionary(
+				DictionaryEncoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			GoStringUtf8Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestIterVariant", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestIterVariant(
+				VariantEncoder.DecodeTypePtr(args[0]),
+			)
+			Int64Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestIterVariant(
+				VariantEncoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			Int64Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestRanOnMain", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestRanOnMain()
+			BoolEncoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestRanOnMain()
+			BoolEncoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestReadyNotified", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestReadyNotified()
+			BoolEncoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestReadyNotified()
+			BoolEncoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestReloadExampleAuto", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*Example).TestReloadExampleAuto()
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*Example).TestReloadExampleAuto()
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestRunOnMain", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*Example).TestRunOnMain()
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*Example).TestRunOnMain()
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestSetPositionAndSize", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*Example).TestSetPositionAndSize(
+				Vector2Encoder.DecodeTypePtr(args[0]),
+				Vector2Encoder.DecodeTypePtr(args[1]),
+			)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*Example).TestSetPositionAndSize(
+				Vector2Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+				Vector2Encoder.DecodeVariantPtr(args[1].NativeConstPtr()),
+			)
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestStatic", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestStatic(
+				Int32Encoder.DecodeTypePtr(args[0]),
+				Int32Encoder.DecodeTypePtr(args[1]),
+			)
+			Int32Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestStatic(
+				Int32Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+				Int32Encoder.DecodeVariantPtr(args[1].NativeConstPtr()),
+			)
+			Int32Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestStatic2", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*Example).TestStatic2()
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*Example).TestStatic2()
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestStrUtility", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestStrUtility()
+			GoStringUtf8Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestStrUtility()
+			GoStringUtf8Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestStringOps", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestStringOps()
+			GoStringUtf8Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestStringOps()
+			GoStringUtf8Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestSubmitGroupTask", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestSubmitGroupTask(
+				Int64Encoder.DecodeTypePtr(args[0]),
+			)
+			Int64Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestSubmitGroupTask(
+				Int64Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			Int64Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestSubmitTask", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestSubmitTask(
+				Int64Encoder.DecodeTypePtr(args[0]),
+			)
+			Int64Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestSubmitTask(
+				Int64Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			Int64Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestTArray", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestTArray()
+			ArrayEncoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestTArray()
+			ArrayEncoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestTArrayArg", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestTArrayArg(
+				PackedInt64ArrayEncoder.DecodeTypePtr(args[0]),
+			)
+			Int64Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestTArrayArg(
+				PackedInt64ArrayEncoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			Int64Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestTaskSum", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestTaskSum()
+			Int64Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestTaskSum()
+			Int64Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestVariantEqual", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestVariantEqual(
+				VariantEncoder.DecodeTypePtr(args[0]),
+				VariantEncoder.DecodeTypePtr(args[1]),
+			)
+			BoolEncoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestVariantEqual(
+				VariantEncoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+				VariantEncoder.DecodeVariantPtr(args[1].NativeConstPtr()),
+			)
+			BoolEncoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestVariantHash", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestVariantHash(
+				VariantEncoder.DecodeTypePtr(args[0]),
+			)
+			Int64Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestVariantHash(
+				VariantEncoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			Int64Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestVariantHashCompare", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestVariantHashCompare(
+				VariantEncoder.DecodeTypePtr(args[0]),
+				VariantEncoder.DecodeTypePtr(args[1]),
+			)
+			BoolEncoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestVariantHashCompare(
+				VariantEncoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+				VariantEncoder.DecodeVariantPtr(args[1].NativeConstPtr()),
+			)
+			BoolEncoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestVariantKeys", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestVariantKeys(
+				ArrayEncoder.DecodeTypePtr(args[0]),
+			)
+			Int64Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestVariantKeys(
+				ArrayEncoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			Int64Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestVariantVector2iConversion", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestVariantVector2iConversion(
+				VariantEncoder.DecodeTypePtr(args[0]),
+			)
+			Vector2iEncoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestVariantVector2iConversion(
+				VariantEncoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			Vector2iEncoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("TestVectorOps", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).TestVectorOps()
+			Int32Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).TestVectorOps()
+			Int32Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("V_PropertyCanRevert", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).V_PropertyCanRevert(
+				StringNameEncoder.DecodeTypePtr(args[0]),
+			)
+			BoolEncoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).V_PropertyCanRevert(
+				StringNameEncoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			BoolEncoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("V_Ready", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*Example).V_Ready()
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*Example).V_Ready()
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("V_Set", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).V_Set(
+				GoStringUtf8Encoder.DecodeTypePtr(args[0]),
+				VariantEncoder.DecodeTypePtr(args[1]),
+			)
+			BoolEncoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).V_Set(
+				GoStringUtf8Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+				VariantEncoder.DecodeVariantPtr(args[1].NativeConstPtr()),
+			)
+			BoolEncoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*Example]("V_ToString", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*Example).V_ToString()
+			GoStringUtf8Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*Example).V_ToString()
+			GoStringUtf8Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleAbstractBase]("GetBaseValue", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*ExampleAbstractBase).GetBaseValue()
+			Int64Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*ExampleAbstractBase).GetBaseValue()
+			Int64Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleAuto]("Damage", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*ExampleAuto).Damage(
+				Int64Encoder.DecodeTypePtr(args[0]),
+			)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*ExampleAuto).Damage(
+				Int64Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleAuto]("GetHealedTotal", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*ExampleAuto).GetHealedTotal()
+			Int64Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*ExampleAuto).GetHealedTotal()
+			Int64Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleAuto]("GetHealth", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*ExampleAuto).GetHealth()
+			Int64Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*ExampleAuto).GetHealth()
+			Int64Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleAuto]("GetLabel", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*ExampleAuto).GetLabel()
+			GoStringUtf8Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*ExampleAuto).GetLabel()
+			GoStringUtf8Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleAuto]("Heal", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*ExampleAuto).Heal(
+				Int64Encoder.DecodeTypePtr(args[0]),
+			)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*ExampleAuto).Heal(
+				Int64Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleAuto]("Move", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*ExampleAuto).Move(
+				Float64Encoder.DecodeTypePtr(args[0]),
+			)
+			Float64Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*ExampleAuto).Move(
+				Float64Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			Float64Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleAuto]("Scan", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*ExampleAuto).Scan()
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*ExampleAuto).Scan()
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleAuto]("SetHealth", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*ExampleAuto).SetHealth(
+				Int64Encoder.DecodeTypePtr(args[0]),
+			)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*ExampleAuto).SetHealth(
+				Int64Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleAuto]("StopHealTracking", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*ExampleAuto).StopHealTracking()
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*ExampleAuto).StopHealTracking()
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleAuto]("Sum", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*ExampleAuto).Sum(
+				Int64Encoder.DecodeTypePtr(args[0]),
+				Int64Encoder.DecodeTypePtr(args[1]),
+			)
+			Int64Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*ExampleAuto).Sum(
+				Int64Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+				Int64Encoder.DecodeVariantPtr(args[1].NativeConstPtr()),
+			)
+			Int64Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleAuto]("V_Ready", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*ExampleAuto).V_Ready()
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*ExampleAuto).V_Ready()
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleConcrete]("GetConcreteValue", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*ExampleConcrete).GetConcreteValue()
+			Int64Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*ExampleConcrete).GetConcreteValue()
+			Int64Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleInternal]("GetTheAnswer", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*ExampleInternal).GetTheAnswer()
+			Int64Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*ExampleInternal).GetTheAnswer()
+			Int64Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleRef]("GetId", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*ExampleRef).GetId()
+			Int32Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*ExampleRef).GetId()
+			Int32Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleRef]("SetId", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*ExampleRef).SetId(
+				Int32Encoder.DecodeTypePtr(args[0]),
+			)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*ExampleRef).SetId(
+				Int32Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleRuntime]("IsProcessed", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*ExampleRuntime).IsProcessed()
+			BoolEncoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*ExampleRuntime).IsProcessed()
+			BoolEncoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleRuntime]("V_Ready", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*ExampleRuntime).V_Ready()
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*ExampleRuntime).V_Ready()
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleSlots]("GetSlotCount", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*ExampleSlots).GetSlotCount()
+			Int64Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*ExampleSlots).GetSlotCount()
+			Int64Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleSlots]("SetSlotCount", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			inst.(*ExampleSlots).SetSlotCount(
+				Int64Encoder.DecodeTypePtr(args[0]),
+			)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			inst.(*ExampleSlots).SetSlotCount(
+				Int64Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			GDExtensionVariantPtrWithNil(rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleSlots]("V_Set", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*ExampleSlots).V_Set(
+				GoStringUtf8Encoder.DecodeTypePtr(args[0]),
+				VariantEncoder.DecodeTypePtr(args[1]),
+			)
+			BoolEncoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*ExampleSlots).V_Set(
+				GoStringUtf8Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+				VariantEncoder.DecodeVariantPtr(args[1].NativeConstPtr()),
+			)
+			BoolEncoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+	RegisterMethodTrampoline[*ExampleVirtual]("Hit", MethodTrampoline{
+		Ptrcall: func(inst GDClass, args []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
+			ret := inst.(*ExampleVirtual).Hit(
+				Int64Encoder.DecodeTypePtr(args[0]),
+			)
+			Int64Encoder.EncodeTypePtrArg(ret, rReturn)
+		},
+		Call: func(inst GDClass, args []Variant, rReturn GDExtensionUninitializedVariantPtr) {
+			ret := inst.(*ExampleVirtual).Hit(
+				Int64Encoder.DecodeVariantPtr(args[0].NativeConstPtr()),
+			)
+			Int64Encoder.EncodeVariantPtrArg(ret, rReturn)
+		},
+	})
+}
@@ -1,9 +1,11 @@
 package pkg
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
+	"sync/atomic"
 
 	. "github.com/godot-go/godot-go/pkg/builtin"
 	. "github.com/godot-go/godot-go/pkg/constant"
@@ -33,14 +35,39 @@ const (
 	FlagTwo ExampleBitfieldFlag = 1 << 1
 )
 
+// ExampleHit is passed to and from GDScript as a Dictionary.
+type ExampleHit struct {
+	Position Vector3 `godot:"position"`
+	Damage   int64
+	Tags     []string `godot:",omitempty"`
+	Source   ExampleHitSource
+	note     string
+}
+
+type ExampleHitSource struct {
+	Name     string
+	Critical bool `godot:",omitempty"`
+}
+
 // Example implements GDClass evidence
 var _ GDClass = (*Example)(nil)
 
+// Example overrides engine virtual methods evidence; they are bound when the
+// class is registered
+var (
+	_ NodeReadyVirtual = (*Example)(nil)
+	_ NodeInputVirtual = (*Example)(nil)
+)
+
 type Example struct {
 	ControlImpl
 	customPosition   Vector2
 	propertyFromList Vector3
 	dprop            [3]Vector2
+	speed            int64
+	readyNotified    bool
+	ranOnMain        bool
+	taskSum          atomic.Int64
 }
 
 func (c *Example) GetClassName() string {
@@ -61,12 +88,19 @@ func (e *Example) SimpleConstFunc(a int64) {
 	e.EmitCustomSignal("simple_const_func", 4)
 }
 
-// func (e *Example) CustomRefFunc(pRef *ExampleRef) int32 {
-// 	if pRef.IsValid() {
-// 		return pRef.Ptr().(*ExampleRef).GetId()
-// 	}
-// 	return -1
-// }
+func (e *Example) CustomRefFunc(pRef *TypedRef[*ExampleRef]) int32 {
+	if pRef.IsValid() {
+		return pRef.TypedPtr().GetId()
+	}
+	return -1
+}
+
+func (e *Example) CustomConstRefFunc(pRef *TypedRef[*ExampleRef]) int32 {
+	if pRef.IsValid() {
+		return pRef.TypedPtr().GetId()
+	}
+	return -1
+}
 
 func (e *Example) ReturnSomething(base string, f32 float32, f64 float64,
 	i int, i8 int8, i16 int16, i32 int32, i64 int64) string {
@@ -88,9 +122,24 @@ func (e *Example) ReturnSomethingConst() Viewport {
 	return result
 }
 
-func (e *Example) ReturnEmptyRef() *ExampleRef {
-	var ref ExampleRef
-	return &ref
+func (e *Example) ReturnEmptyRef() *TypedRef[*ExampleRef] {
+	return &TypedRef[*ExampleRef]{}
+}
+
+func (e *Example) ReturnExtendedRef() *TypedRef[*ExampleRef] {
+	return NewGDClassRef[*ExampleRef]()
+}
+
+func (e *Example) ExtendedRefChecks(pRef *TypedRef[*ExampleRef]) *TypedRef[*ExampleRef] {
+	ref := NewGDClassRef[*ExampleRef]()
+	if pRef.IsValid() {
+		ref.TypedPtr().SetId(pRef.TypedPtr().GetId())
+	}
+	return ref
+}
+
+func (e *Example) TestGetInternalClass() *ExampleInternal {
+	return CreateGDClassInstance("ExampleInternal").(*ExampleInternal)
 }
 
 func (e *Example) GetV4() Vector4 {
@@ -124,65 +173,72 @@ func (e *Example) TestArray() Array {
 	arr := NewArray()
 	arr.Insert(0, NewVariantInt64(1))
 	arr.Insert(1, NewVariantInt64(2))
-	v := NewVariantArray(arr)
-	v0, err := v.GetIndexed(0)
-	if err != nil {
-		log.Panic("error getting index 0: %w",
-			zap.Error(err),
-		)
-	}
-	gdV0 := v0.ToString()
-	v1, err := v.GetIndexed(1)
-	if err != nil {
-		log.Panic("error getting index 0: %w",
-			zap.Error(err),
-		)
-	}
-	gdV1 := v1.ToString()
-	log.Info("arr size",
-		zap.Any("size", arr.Size()),
-		zap.String("v[0]", gdV0.ToUtf8()),
-		zap.String("v[1]", gdV1.ToUtf8()),
-	)
-	if v0.GetType() != GDEXTENSION_VARIANT_TYPE_INT {
-		log.Panic("array value at index 0 is not a INT variant type",
-			zap.Any("actual", v0.GetType()),
-			zap.Any("expected", GDEXTENSION_VARIANT_TYPE_INT),
-		)
-	}
-	if v0.ToInt64() != 1 {
-		log.Panic("array value at index 0 is not equal",
-			zap.Int64("actual", v0.ToInt64()),
-			zap.Int64("expected", 1),
-		)
-	}
-	if v1.GetType() != GDEXTENSION_VARIANT_TYPE_INT {
-		log.Panic("array value at index 1 is not a INT variant type",
-			zap.Any("actual", v1.GetType()),
-			zap.Any("expected", GDEXTENSION_VARIANT_TYPE_INT),
-		)
-	}
-	if v1.ToInt64() != 2 {
-		log.Panic("array value at index 1 is not equal",
-			zap.Int64("actual", v0.ToInt64()),
-			zap.Int64("expected", 2),
-		)
+	for i, v := range arr.All() {
+		if v.GetType() != GDEXTENSION_VARIANT_TYPE_INT {
+			log.Panic("array value is not a INT variant type",
+				zap.Int64("index", i),
+				zap.Any("actual", v.GetType()),
+				zap.Any("expected", GDEXTENSION_VARIANT_TYPE_INT),
+			)
+		}
+		if v.ToInt64() != i+1 {
+			log.Panic("array value is not equal",
+				zap.Int64("index", i),
+				zap.Int64("actual", v.ToInt64()),
+				zap.Int64("expected", i+1),
+			)
+		}
 	}
-	ret := v.ToArray()
-	pr := ret.PickRandom()
+	log.Info("arr size", zap.Any("size", arr.Size()))
+	pr := arr.PickRandom()
 	log.Info("pick random", zap.Int64("val", pr.ToInt64()))
-	return ret
+	return arr
 }
 
 func (e *Example) TestTArrayArg(arr PackedInt64Array) int64 {
 	sum := int64(0)
-	sz := arr.Size()
-	for i := range sz {
-		sum += arr.GetIndexed(i)
+	for v := range arr.Values() {
+		sum += v
 	}
 	return sum
 }
 
+func (e *Example) TestIterDictionary(dict Dictionary) string {
+	var sb strings.Builder
+	for k, v := range dict.All() {
+		fmt.Fprintf(&sb, "%s=%s;", k.Stringify(), v.Stringify())
+	}
+	return sb.String()
+}
+
+func (e *Example) TestVariantHash(v Variant) int64 {
+	return v.Hash()
+}
+
+func (e *Example) TestVariantEqual(a, b Variant) bool {
+	return a.Equal(b)
+}
+
+func (e *Example) TestVariantHashCompare(a, b Variant) bool {
+	return a.HashCompare(b)
+}
+
+func (e *Example) TestVariantKeys(values Array) int64 {
+	keys := map[VariantKey]struct{}{}
+	for v := range values.Values() {
+		keys[v.Key()] = struct{}{}
+	}
+	return int64(len(keys))
+}
+
+func (e *Example) TestIterVariant(v Variant) int64 {
+	count := int64(0)
+	for range v.Iter() {
+		count++
+	}
+	return count
+}
+
 func (e *Example) TestTArray() Array {
 	parr := NewPackedVector2Array()
 	parr.Resize(2)
@@ -203,6 +259,119 @@ func (e *Example) TestDictionary() Dictionary {
 	return dict
 }
 
+func (e *Example) TestSliceSum(values []int64) int64 {
+	sum := int64(0)
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
+
+func (e *Example) TestSliceReverse(values []string) []string {
+	ret := make([]string, len(values))
+	for i, v := range values {
+		ret[len(values)-1-i] = v
+	}
+	return ret
+}
+
+func (e *Example) TestSliceBytes(data []byte) []byte {
+	ret := make([]byte, len(data))
+	for i, b := range data {
+		ret[i] = b + 1
+	}
+	return ret
+}
+
+func (e *Example) TestSliceGrid(size int64) []Vector2i {
+	ret := make([]Vector2i, 0, size*size)
+	for y := range size {
+		for x := range size {
+			ret = append(ret, NewVector2iWithInt64Int64(x, y))
+		}
+	}
+	return ret
+}
+
+func (e *Example) TestMapCount(words []string) map[string]int64 {
+	ret := make(map[string]int64)
+	for _, w := range words {
+		ret[w]++
+	}
+	return ret
+}
+
+func (e *Example) TestMapSum(values map[string]int64) int64 {
+	sum := int64(0)
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
+
+func (e *Example) TestStructHit(damage int64) ExampleHit {
+	return ExampleHit{
+		Position: NewVector3WithFloat32Float32Float32(1, 2, 3),
+		Damage:   damage,
+		Source:   ExampleHitSource{Name: "go"},
+		note:     "not exported",
+	}
+}
+
+func (e *Example) TestStructDamage(hit ExampleHit) int64 {
+	if hit.Source.Critical {
+		return hit.Damage * 2
+	}
+	return hit.Damage
+}
+
+func (e *Example) TestStructHits(hits []ExampleHit) int64 {
+	sum := int64(0)
+	for _, hit := range hits {
+		sum += e.TestStructDamage(hit)
+	}
+	return sum
+}
+
+func (e *Example) TestTypedArrayRange(n int64) TypedArray[int64] {
+	ret := NewTypedArray[int64]()
+	for i := range n {
+		ret.Append(i)
+	}
+	return ret
+}
+
+func (e *Example) TestTypedArraySum(values TypedArray[int64]) int64 {
+	sum := int64(0)
+	for v := range values.Values() {
+		sum += v
+	}
+	return sum
+}
+
+func (e *Example) TestTypedArrayFirst(examples TypedArray[*Example]) *Example {
+	if examples.IsEmpty() {
+		return nil
+	}
+	return examples.Get(0)
+}
+
+func (e *Example) TestTypedDictionaryCount(words []string) TypedDictionary[string, int64] {
+	ret := NewTypedDictionary[string, int64]()
+	for _, w := range words {
+		n, _ := ret.Get(w)
+		ret.Set(w, n+1)
+	}
+	return ret
+}
+
+func (e *Example) TestTypedDictionaryGet(values TypedDictionary[string, int64], key string) int64 {
+	if v, ok := values.Get(key); ok {
+		return v
+	}
+	return -1
+}
+
 func (e *Example) SetCustomPosition(pos Vector2) {
 	e.customPosition = pos
 }
@@ -211,6 +380,14 @@ func (e *Example) GetCustomPosition() Vector2 {
 	return e.customPosition
 }
 
+func (e *Example) SetSpeed(speed int64) {
+	e.speed = speed
+}
+
+func (e *Example) GetSpeed() int64 {
+	return e.speed
+}
+
 func (e *Example) SetPropertyFromList(v Vector3) {
 	e.propertyFromList = v
 }
@@ -256,6 +433,25 @@ func (e *Example) TestStatic2() {
 	println("  void static")
 }
 
+// ExampleStaticJoin is bound as a static method from a plain function.
+func ExampleStaticJoin(p_a, p_b string) string {
+	return p_a + " " + p_b
+}
+
+func (e *Example) TestErrorReturn(p_fail bool) error {
+	if p_fail {
+		return fmt.Errorf("test error return: %w", ERR_FILE_NOT_FOUND)
+	}
+	return nil
+}
+
+func (e *Example) TestValueAndError(p_value int64) (int64, error) {
+	if p_value < 0 {
+		return 0, fmt.Errorf("negative value %d: %w", p_value, ERR_INVALID_PARAMETER)
+	}
+	return p_value * 2, nil
+}
+
 func (e *Example) TestStringOps() string {
 	s := NewStringWithUtf8Chars("A")
 	defer s.Destroy()
@@ -294,6 +490,15 @@ func (e *Example) V_PropertyCanRevert(p_name StringName) bool {
 	return p_name.Equal_StringName(gdSn) && !e.propertyFromList.Equal_Vector3(vec3)
 }
 
+func (e *Example) V_PropertyGetRevert(p_name StringName) (Variant, bool) {
+	gdSn := NewStringNameWithLatin1Chars("property_from_list")
+	defer gdSn.Destroy()
+	if !p_name.Equal_StringName(gdSn) {
+		return Variant{}, false
+	}
+	return NewVariantVector3(NewVector3WithFloat32Float32Float32(42, 42, 42)), true
+}
+
 func (e *Example) V_Set(name string, value Variant) bool {
 	if strings.HasPrefix(name, "dproperty") {
 		tokens := strings.SplitN(name, "_", 2)
@@ -527,10 +732,105 @@ func (e *Example) CallableBind() {
 	c.Callv(args)
 }
 
+func (e *Example) TestCallableFromFunc() Callable {
+	return NewCallableFromFunc(func(a, b int64) bool {
+		return a > b
+	})
+}
+
+func (e *Example) TestCallableFromVariadicFunc() Callable {
+	return NewCallableFromFunc(func(args ...Variant) int64 {
+		return int64(len(args))
+	})
+}
+
+// TestAwaitSignal awaits custom_signal emitted by EmitCustomSignal and
+// returns the received value.
+func (e *Example) TestAwaitSignal(value int64) int64 {
+	ch := Await(e, "custom_signal")
+	e.EmitCustomSignal("await", value)
+	args, ok := <-ch
+	for i := range args {
+		defer args[i].Destroy()
+	}
+	if !ok || len(args) != 2 {
+		return -1
+	}
+	return args[1].ToInt64()
+}
+
+// TestAwaitContextCanceled reports whether canceling the context closes the
+// channel without a value. The handler stays connected until the end of the
+// frame, when it is disconnected on the main thread.
+func (e *Example) TestAwaitContextCanceled() bool {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := AwaitContext(ctx, e, "custom_signal")
+	cancel()
+	_, ok := <-ch
+	return !ok
+}
+
 func (e *Example) TestVariantVector2iConversion(v Variant) Vector2i {
 	return v.ToVector2i()
 }
 
+func (e *Example) V_Notification(what int32, reversed bool) {
+	switch what {
+	case NODE_NOTIFICATION_READY:
+		e.readyNotified = true
+	case OBJECT_NOTIFICATION_PREDELETE:
+		log.Debug("Example predelete notification received")
+	}
+}
+
+func (e *Example) TestReadyNotified() bool {
+	return e.readyNotified
+}
+
+// TestReloadExampleAuto puts the live ExampleAuto instances through a hot
+// reload of the extension.
+func (e *Example) TestReloadExampleAuto() {
+	ReloadClassInstances[*ExampleAuto]()
+}
+
+// TestRunOnMain calls back into the main thread from a goroutine; the result
+// is reported by TestRanOnMain in a later frame.
+func (e *Example) TestRunOnMain() {
+	go func() {
+		waited, err := RunOnMainWait(func() bool {
+			return IsMainThread()
+		})
+		RunOnMain(func() {
+			e.ranOnMain = err == nil && waited && IsMainThread()
+		})
+	}()
+}
+
+func (e *Example) TestRanOnMain() bool {
+	return e.ranOnMain
+}
+
+// TestSubmitTask adds value to the task sum on a worker thread.
+func (e *Example) TestSubmitTask(value int64) int64 {
+	id := SubmitTask(func() {
+		e.taskSum.Add(value)
+	}, false, "Example.TestSubmitTask")
+	return int64(id)
+}
+
+// TestSubmitGroupTask adds every index in [0, elements) to the task sum on
+// worker threads.
+func (e *Example) TestSubmitGroupTask(elements int64) int64 {
+	id := SubmitGroupTask(func(index uint32) {
+		e.taskSum.Add(int64(index))
+	}, int(elements), -1, false, "Example.TestSubmitGroupTask")
+	return int64(id)
+}
+
+func (e *Example) TestTaskSum() int64 {
+	return e.taskSum.Load()
+}
+
 func (e *Example) V_ToString() string {
 	return fmt.Sprintf("[ GDExtension::Example <--> Instance ID:%d ]", e.GetInstanceId())
 }
@@ -560,26 +860,49 @@ func GetExamplePropertyList() []GDExtensionPropertyInfo {
 
 func RegisterClassExample() {
 	ClassDBRegisterClass(NewExampleFromOwnerObject, GetExamplePropertyList(), ValidateExampleProperty, func(t *Example) {
-		// virtuals
+		// virtuals; V_Ready and V_Input are bound through their interfaces
 		ClassDBBindMethodVirtual(t, "V_ToString", "to_string", nil, nil)
-		ClassDBBindMethodVirtual(t, "V_Ready", "_ready", nil, nil)
-		ClassDBBindMethodVirtual(t, "V_Input", "_input", []string{"event"}, nil)
 		ClassDBBindMethodVirtual(t, "V_Set", "_set", []string{"name", "value"}, nil)
 		ClassDBBindMethodVirtual(t, "V_Get", "_get", []string{"name"}, nil)
 		ClassDBBindMethodVirtual(t, "V_PropertyCanRevert", "_property_can_revert", []string{"name"}, nil)
+		ClassDBBindMethodVirtual(t, "V_PropertyGetRevert", "_property_get_revert", []string{"name"}, nil)
 
 		ClassDBBindMethod(t, "SimpleFunc", "simple_func", nil, nil)
 		ClassDBBindMethod(t, "SimpleConstFunc", "simple_const_func", []string{"a"}, nil)
-		// ClassDBBindMethod(t, "CustomRefFunc", "custom_ref_func", []string{"ref"}, nil)
+		ClassDBBindMethod(t, "CustomRefFunc", "custom_ref_func", []string{"ref"}, nil)
+		ClassDBBindMethod(t, "CustomConstRefFunc", "custom_const_ref_func", []string{"ref"}, nil)
 		ClassDBBindMethod(t, "ImageRefFunc", "image_ref_func", []string{"image"}, nil)
 		ClassDBBindMethod(t, "ReturnSomething", "return_something", []string{"base", "f32", "f64", "i", "i8", "i16", "i32", "i64"}, nil)
 		ClassDBBindMethod(t, "ReturnSomethingConst", "return_something_const", nil, nil)
 		ClassDBBindMethod(t, "ReturnEmptyRef", "return_empty_ref", nil, nil)
+		ClassDBBindMethod(t, "ReturnExtendedRef", "return_extended_ref", nil, nil)
+		ClassDBBindMethod(t, "ExtendedRefChecks", "extended_ref_checks", []string{"ref"}, nil)
+		ClassDBBindMethod(t, "TestGetInternalClass", "test_get_internal_class", nil, nil)
 
 		ClassDBBindMethod(t, "TestArray", "test_array", nil, nil)
 		ClassDBBindMethod(t, "TestTArrayArg", "test_tarray_arg", []string{"array"}, nil)
 		ClassDBBindMethod(t, "TestTArray", "test_tarray", nil, nil)
 		ClassDBBindMethod(t, "TestDictionary", "test_dictionary", nil, nil)
+		ClassDBBindMethod(t, "TestSliceSum", "test_slice_sum", []string{"values"}, nil)
+		ClassDBBindMethod(t, "TestSliceReverse", "test_slice_reverse", []string{"values"}, nil)
+		ClassDBBindMethod(t, "TestSliceBytes", "test_slice_bytes", []string{"data"}, nil)
+		ClassDBBindMethod(t, "TestSliceGrid", "test_slice_grid", []string{"size"}, nil)
+		ClassDBBindMethod(t, "TestMapCount", "test_map_count", []string{"words"}, nil)
+		ClassDBBindMethod(t, "TestMapSum", "test_map_sum", []string{"values"}, nil)
+		ClassDBBindMethod(t, "TestStructHit", "test_struct_hit", []string{"damage"}, nil)
+		ClassDBBindMethod(t, "TestStructDamage", "test_struct_damage", []string{"hit"}, nil)
+		ClassDBBindMethod(t, "TestStructHits", "test_struct_hits", []string{"hits"}, nil)
+		ClassDBBindMethod(t, "TestIterDictionary", "test_iter_dictionary", []string{"dict"}, nil)
+		ClassDBBindMethod(t, "TestIterVariant", "test_iter_variant", []string{"v"}, nil)
+		ClassDBBindMethod(t, "TestVariantHash", "test_variant_hash", []string{"v"}, nil)
+		ClassDBBindMethod(t, "TestVariantEqual", "test_variant_equal", []string{"a", "b"}, nil)
+		ClassDBBindMethod(t, "TestVariantHashCompare", "test_variant_hash_compare", []string{"a", "b"}, nil)
+		ClassDBBindMethod(t, "TestVariantKeys", "test_variant_keys", []string{"values"}, nil)
+		ClassDBBindMethod(t, "TestTypedArrayRange", "test_typed_array_range", []string{"n"}, nil)
+		ClassDBBindMethod(t, "TestTypedArraySum", "test_typed_array_sum", []string{"values"}, nil)
+		ClassDBBindMethod(t, "TestTypedArrayFirst", "test_typed_array_first", []string{"examples"}, nil)
+		ClassDBBindMethod(t, "TestTypedDictionaryCount", "test_typed_dictionary_count", []string{"words"}, nil)
+		ClassDBBindMethod(t, "TestTypedDictionaryGet", "test_typed_dictionary_get", []string{"values", "key"}, nil)
 		ClassDBBindMethod(t, "TestNodeArgument", "test_node_argument", []string{"example"}, nil)
 		ClassDBBindMethod(t, "TestStringOps", "test_string_ops", nil, nil)
 		ClassDBBindMethod(t, "TestStrUtility", "test_str_utility", nil, nil)
@@ -592,15 +915,33 @@ func RegisterClassExample() {
 		ClassDBBindMethodVarargs(t, "VarargsFuncVoid", "varargs_func_void", nil, nil)
 
 		ClassDBBindMethod(t, "DefArgs", "def_args", []string{"a", "b"}, []Variant{NewVariantInt64(100), NewVariantInt64(200)})
-		// ClassDBBindMethodStatic(t, "TestStatic", "test_static", []string{"a", "b"}, nil)
-		// ClassDBBindMethodStatic(t, "TestStatic2", "test_static2", nil, nil)
+		ClassDBBindMethodStatic(t, "TestStatic", "test_static", []string{"a", "b"}, nil)
+		ClassDBBindMethodStatic(t, "TestStatic2", "test_static2", nil, nil)
+		ClassDBBindStaticFunc(t, ExampleStaticJoin, "test_static_join", []string{"a", "b"}, nil)
+
+		// error returns
+		ClassDBBindMethod(t, "TestErrorReturn", "test_error_return", []string{"fail"}, nil)
+		ClassDBBindMethod(t, "TestValueAndError", "test_value_and_error", []string{"value"}, nil)
 
 		ClassDBBindMethod(t, "TestSetPositionAndSize", "test_set_position_and_size", nil, nil)
 		ClassDBBindMethod(t, "TestGetChildNode", "test_get_child_node", nil, nil)
 		ClassDBBindMethod(t, "TestCharacterBody2D", "test_character_body_2d", []string{"body"}, nil)
 		ClassDBBindMethod(t, "TestParentIsNil", "test_parent_is_nil", nil, nil)
+		ClassDBBindMethod(t, "TestReadyNotified", "test_ready_notified", nil, nil)
+		ClassDBBindMethod(t, "TestReloadExampleAuto", "test_reload_example_auto", nil, nil)
+		ClassDBBindMethod(t, "TestRunOnMain", "test_run_on_main", nil, nil)
+		ClassDBBindMethod(t, "TestRanOnMain", "test_ran_on_main", nil, nil)
+		ClassDBBindMethod(t, "TestSubmitTask", "test_submit_task", []string{"value"}, nil)
+		ClassDBBindMethod(t, "TestSubmitGroupTask", "test_submit_group_task", []string{"elements"}, nil)
+		ClassDBBindMethod(t, "TestTaskSum", "test_task_sum", nil, nil)
 
 		// Properties
+		ClassDBBindMethod(t, "GetSpeed", "get_speed", nil, nil)
+		ClassDBBindMethod(t, "SetSpeed", "set_speed", []string{"speed"}, nil)
+		speedDefault := NewVariantInt64(10)
+		defer speedDefault.Destroy()
+		ClassDBAddProperty(t, GDEXTENSION_VARIANT_TYPE_INT, "speed", "set_speed", "get_speed", PropertyRange(0, 100, 1, "or_greater"), WithPropertyDefault(speedDefault))
+
 		ClassDBAddPropertyGroup(t, "Test group", "group_")
 		ClassDBAddPropertySubgroup(t, "Test subgroup", "group_subgroup_")
 
@@ -630,6 +971,10 @@ func RegisterClassExample() {
 		ClassDBBindMethod(t, "TestBitfield", "test_bitfield", []string{"flags"}, nil)
 
 		ClassDBBindMethod(t, "CallableBind", "callable_bind", nil, nil)
+		ClassDBBindMethod(t, "TestCallableFromFunc", "test_callable_from_func", nil, nil)
+		ClassDBBindMethod(t, "TestCallableFromVariadicFunc", "test_callable_from_variadic_func", nil, nil)
+		ClassDBBindMethod(t, "TestAwaitSignal", "test_await_signal", []string{"value"}, nil)
+		ClassDBBindMethod(t, "TestAwaitContextCanceled", "test_await_context_canceled", nil, nil)
 		ClassDBBindMethod(t, "TestVariantVector2iConversion", "test_variant_vector2i_conversion", []string{"variant"}, nil)
 
 		// others
@@ -0,0 +1,75 @@
+package pkg
+
+import (
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/core"
+	. "github.com/godot-go/godot-go/pkg/gdclassimpl"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// ExampleAbstractBase implements GDClass evidence
+var _ GDClass = (*ExampleAbstractBase)(nil)
+
+// ExampleAbstractBase is registered as an abstract class; only classes
+// embedding it, such as ExampleConcrete, can be instantiated.
+type ExampleAbstractBase struct {
+	NodeImpl
+}
+
+func (c *ExampleAbstractBase) GetClassName() string {
+	return "ExampleAbstractBase"
+}
+
+func (c *ExampleAbstractBase) GetParentClassName() string {
+	return "Node"
+}
+
+func (e *ExampleAbstractBase) GetBaseValue() int64 {
+	return 21
+}
+
+func NewExampleAbstractBaseFromOwnerObject(owner *GodotObject) GDClass {
+	obj := &ExampleAbstractBase{}
+	obj.SetGodotObjectOwner(owner)
+	return obj
+}
+
+// ExampleConcrete implements GDClass evidence
+var _ GDClass = (*ExampleConcrete)(nil)
+
+type ExampleConcrete struct {
+	ExampleAbstractBase
+}
+
+func (c *ExampleConcrete) GetClassName() string {
+	return "ExampleConcrete"
+}
+
+func (c *ExampleConcrete) GetParentClassName() string {
+	return "ExampleAbstractBase"
+}
+
+func (e *ExampleConcrete) GetConcreteValue() int64 {
+	return e.GetBaseValue() * 2
+}
+
+func NewExampleConcreteFromOwnerObject(owner *GodotObject) GDClass {
+	obj := &ExampleConcrete{}
+	obj.SetGodotObjectOwner(owner)
+	return obj
+}
+
+func RegisterClassExampleAbstract() {
+	if err := ClassDBRegisterClassAuto[*ExampleAbstractBase](NewExampleAbstractBaseFromOwnerObject, nil, ClassAbstract()); err != nil {
+		log.Panic("unable to register ExampleAbstractBase", zap.Error(err))
+	}
+	if err := ClassDBRegisterClassAuto[*ExampleConcrete](NewExampleConcreteFromOwnerObject, nil); err != nil {
+		log.Panic("unable to register ExampleConcrete", zap.Error(err))
+	}
+}
+
+func UnregisterClassExampleAbstract() {
+	ClassDBUnregisterClass[*ExampleConcrete]()
+	ClassDBUnregisterClass[*ExampleAbstractBase]()
+}
@@ -0,0 +1,130 @@
+package pkg
+
+import (
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/core"
+	. "github.com/godot-go/godot-go/pkg/gdclassimpl"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// ExampleAuto implements GDClass evidence
+var _ GDClass = (*ExampleAuto)(nil)
+
+// ExampleAuto is registered with ClassDBRegisterClassAuto; its bindings are
+// derived from its methods and struct tags.
+type ExampleAuto struct {
+	NodeImpl
+	_             struct{}                       `godot:"group=Stats,prefix=stats_"`
+	health        int64                          `godot:"property,name=stats_health,hint=range,hint_string=0,100,1"`
+	label         string                         `godot:"property"`
+	title         String                         `godot:"export"`
+	items         Array                          `godot:"export"`
+	payload       Variant                        `godot:"export"`
+	_             struct{}                       `godot:"group=Movement,prefix=movement_"`
+	movementSpeed float64                        `godot:"export,hint=range,hint_string=0,10,0.5"`
+	Damaged       func(amount int64)             `godot:"signal,args=amount"`
+	Healed        Signal1[int64]                 `godot:"signal,args=amount"`
+	Scanned       Signal3[Node, []int64, []bool] `godot:"signal,args=node;values;flags"`
+	_             struct{}                       `godot:"method=Sum,args=a;b,static"`
+	_             struct{}                       `godot:"method=Damage,args=amount"`
+	_             struct{}                       `godot:"method=Heal,args=amount"`
+	_             struct{}                       `godot:"method=Move,args=seconds"`
+	healedTotal   int64
+	healTracking  SignalConnection
+}
+
+func (c *ExampleAuto) GetClassName() string {
+	return "ExampleAuto"
+}
+
+func (c *ExampleAuto) GetParentClassName() string {
+	return "Node"
+}
+
+func (e *ExampleAuto) GetHealth() int64 {
+	return e.health
+}
+
+func (e *ExampleAuto) SetHealth(health int64) {
+	e.health = health
+}
+
+func (e *ExampleAuto) GetLabel() string {
+	return e.label
+}
+
+func (e *ExampleAuto) Damage(amount int64) {
+	e.health -= amount
+	damaged := NewStringNameWithLatin1Chars("damaged")
+	defer damaged.Destroy()
+	arg0 := NewVariantInt64(amount)
+	defer arg0.Destroy()
+	e.EmitSignal(damaged, arg0)
+}
+
+func (e *ExampleAuto) Heal(amount int64) {
+	e.health += amount
+	e.Healed.Emit(amount)
+}
+
+func (e *ExampleAuto) Scan() {
+	e.Scanned.Emit(e, []int64{e.health, e.healedTotal}, []bool{e.health > 0})
+}
+
+func (e *ExampleAuto) GetHealedTotal() int64 {
+	return e.healedTotal
+}
+
+// StopHealTracking disconnects the Healed handler connected in V_Ready.
+func (e *ExampleAuto) StopHealTracking() {
+	e.healTracking.Disconnect()
+}
+
+// Move returns the distance covered at the exported movement speed.
+func (e *ExampleAuto) Move(seconds float64) float64 {
+	return e.movementSpeed * seconds
+}
+
+func (e *ExampleAuto) Sum(a, b int64) int64 {
+	return a + b
+}
+
+func (e *ExampleAuto) V_Ready() {
+	e.label = "ready"
+	e.healTracking, _ = e.Healed.Connect(func(amount int64) {
+		e.healedTotal += amount
+	})
+}
+
+// V_SerializeState keeps the read-only label across hot reloads.
+func (e *ExampleAuto) V_SerializeState() Dictionary {
+	state := NewDictionary()
+	label := NewVariantGoString(e.label)
+	defer label.Destroy()
+	state.SetKeyed("label", label)
+	return state
+}
+
+func (e *ExampleAuto) V_RestoreState(state Dictionary) {
+	label := state.GetKeyed("label")
+	defer label.Destroy()
+	e.label = label.ToGoString()
+}
+
+func NewExampleAutoFromOwnerObject(owner *GodotObject) GDClass {
+	obj := &ExampleAuto{}
+	obj.SetGodotObjectOwner(owner)
+	return obj
+}
+
+func RegisterClassExampleAuto() {
+	if err := ClassDBRegisterClassAuto[*ExampleAuto](NewExampleAutoFromOwnerObject, nil); err != nil {
+		log.Panic("unable to register ExampleAuto", zap.Error(err))
+	}
+}
+
+func UnregisterClassExampleAuto() {
+	ClassDBUnregisterClass[*ExampleAuto]()
+	log.Debug("ExampleAuto unregistered")
+}
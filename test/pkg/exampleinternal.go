@@ -0,0 +1,46 @@
+package pkg
+
+import (
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/core"
+	. "github.com/godot-go/godot-go/pkg/gdclassimpl"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// ExampleInternal implements GDClass evidence
+var _ GDClass = (*ExampleInternal)(nil)
+
+// ExampleInternal is an internal helper class; it is hidden from the editor
+// but can be returned to scripts.
+type ExampleInternal struct {
+	RefCountedImpl
+}
+
+func (c *ExampleInternal) GetClassName() string {
+	return "ExampleInternal"
+}
+
+func (c *ExampleInternal) GetParentClassName() string {
+	return "RefCounted"
+}
+
+func (e *ExampleInternal) GetTheAnswer() int64 {
+	return 42
+}
+
+func NewExampleInternalFromOwnerObject(owner *GodotObject) GDClass {
+	obj := &ExampleInternal{}
+	obj.SetGodotObjectOwner(owner)
+	return obj
+}
+
+func RegisterClassExampleInternal() {
+	if err := ClassDBRegisterClassAuto[*ExampleInternal](NewExampleInternalFromOwnerObject, nil, ClassInternal()); err != nil {
+		log.Panic("unable to register ExampleInternal", zap.Error(err))
+	}
+}
+
+func UnregisterClassExampleInternal() {
+	ClassDBUnregisterClass[*ExampleInternal]()
+}
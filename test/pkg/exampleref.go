@@ -46,3 +46,7 @@ func RegisterClassExampleRef() {
 		log.Debug("ExampleRef registered")
 	})
 }
+
+func UnregisterClassExampleRef() {
+	ClassDBUnregisterClass[*ExampleRef]()
+}
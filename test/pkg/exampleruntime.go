@@ -0,0 +1,51 @@
+package pkg
+
+import (
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/core"
+	. "github.com/godot-go/godot-go/pkg/gdclassimpl"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// ExampleRuntime implements GDClass evidence
+var _ GDClass = (*ExampleRuntime)(nil)
+
+// ExampleRuntime is a runtime class; the editor only gets placeholder
+// instances of it.
+type ExampleRuntime struct {
+	NodeImpl
+	processed bool
+}
+
+func (c *ExampleRuntime) GetClassName() string {
+	return "ExampleRuntime"
+}
+
+func (c *ExampleRuntime) GetParentClassName() string {
+	return "Node"
+}
+
+func (e *ExampleRuntime) V_Ready() {
+	e.processed = true
+}
+
+func (e *ExampleRuntime) IsProcessed() bool {
+	return e.processed
+}
+
+func NewExampleRuntimeFromOwnerObject(owner *GodotObject) GDClass {
+	obj := &ExampleRuntime{}
+	obj.SetGodotObjectOwner(owner)
+	return obj
+}
+
+func RegisterClassExampleRuntime() {
+	if err := ClassDBRegisterClassAuto[*ExampleRuntime](NewExampleRuntimeFromOwnerObject, nil, ClassRuntime()); err != nil {
+		log.Panic("unable to register ExampleRuntime", zap.Error(err))
+	}
+}
+
+func UnregisterClassExampleRuntime() {
+	ClassDBUnregisterClass[*ExampleRuntime]()
+}
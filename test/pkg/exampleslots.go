@@ -0,0 +1,110 @@
+package pkg
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/core"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	. "github.com/godot-go/godot-go/pkg/gdclassimpl"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// ExampleSlots implements GDClass evidence
+var _ GDClass = (*ExampleSlots)(nil)
+
+// ExampleSlots implements PropertyLister evidence
+var _ PropertyLister = (*ExampleSlots)(nil)
+
+// ExampleSlots lists one slot_<n> property per configured slot.
+type ExampleSlots struct {
+	NodeImpl
+	slotCount int64 `godot:"property,hint=range,hint_string=0,8,1"`
+	slots     []int64
+}
+
+// maxSlotCount is the upper bound of the slot_count range hint.
+const maxSlotCount = 8
+
+func (c *ExampleSlots) GetClassName() string {
+	return "ExampleSlots"
+}
+
+func (c *ExampleSlots) GetParentClassName() string {
+	return "Node"
+}
+
+func (e *ExampleSlots) GetSlotCount() int64 {
+	return e.slotCount
+}
+
+// SetSlotCount clamps count to the range of the property hint, which
+// scripts do not have to respect.
+func (e *ExampleSlots) SetSlotCount(count int64) {
+	count = min(max(count, 0), maxSlotCount)
+	e.slotCount = count
+	slots := make([]int64, count)
+	copy(slots, e.slots)
+	e.slots = slots
+	e.NotifyPropertyListChanged()
+}
+
+func (e *ExampleSlots) V_GetPropertyList() []PropertyInfo {
+	props := make([]PropertyInfo, len(e.slots))
+	for i := range props {
+		props[i] = PropertyInfo{
+			Type: GDEXTENSION_VARIANT_TYPE_INT,
+			Name: fmt.Sprintf("slot_%d", i),
+		}
+	}
+	return props
+}
+
+// slotIndex parses the index of a slot_<n> property.
+func (e *ExampleSlots) slotIndex(name string) (int, bool) {
+	s, ok := strings.CutPrefix(name, "slot_")
+	if !ok {
+		return 0, false
+	}
+	i, err := strconv.Atoi(s)
+	if err != nil || i < 0 || i >= len(e.slots) {
+		return 0, false
+	}
+	return i, true
+}
+
+func (e *ExampleSlots) V_Get(name string) (Variant, bool) {
+	i, ok := e.slotIndex(name)
+	if !ok {
+		return NewVariantNil(), false
+	}
+	return NewVariantInt64(e.slots[i]), true
+}
+
+func (e *ExampleSlots) V_Set(name string, value Variant) bool {
+	i, ok := e.slotIndex(name)
+	if !ok {
+		return false
+	}
+	e.slots[i] = value.ToInt64()
+	return true
+}
+
+func NewExampleSlotsFromOwnerObject(owner *GodotObject) GDClass {
+	obj := &ExampleSlots{}
+	obj.SetGodotObjectOwner(owner)
+	return obj
+}
+
+func RegisterClassExampleSlots() {
+	if err := ClassDBRegisterClassAuto[*ExampleSlots](NewExampleSlotsFromOwnerObject, nil); err != nil {
+		log.Panic("unable to register ExampleSlots", zap.Error(err))
+	}
+}
+
+func UnregisterClassExampleSlots() {
+	ClassDBUnregisterClass[*ExampleSlots]()
+}
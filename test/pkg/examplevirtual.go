@@ -0,0 +1,59 @@
+package pkg
+
+import (
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/core"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	. "github.com/godot-go/godot-go/pkg/gdclassimpl"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// ExampleVirtual implements GDClass evidence
+var _ GDClass = (*ExampleVirtual)(nil)
+
+// ExampleVirtual declares the virtual method _on_hit for scripts to
+// override.
+type ExampleVirtual struct {
+	NodeImpl
+}
+
+func (c *ExampleVirtual) GetClassName() string {
+	return "ExampleVirtual"
+}
+
+func (c *ExampleVirtual) GetParentClassName() string {
+	return "Node"
+}
+
+// Hit returns the damage taken, which scripts can change by overriding
+// _on_hit.
+func (e *ExampleVirtual) Hit(damage int64) int64 {
+	arg := NewVariantInt64(damage)
+	defer arg.Destroy()
+	if taken, ok := CallScriptVirtual[int64](e, "_on_hit", arg); ok {
+		return taken
+	}
+	return damage
+}
+
+func NewExampleVirtualFromOwnerObject(owner *GodotObject) GDClass {
+	obj := &ExampleVirtual{}
+	obj.SetGodotObjectOwner(owner)
+	return obj
+}
+
+func RegisterClassExampleVirtual() {
+	err := ClassDBRegisterClassAuto[*ExampleVirtual](NewExampleVirtualFromOwnerObject, func(t *ExampleVirtual) {
+		ClassDBAddVirtualMethod(t, "_on_hit", []VirtualMethodParam{
+			{Type: GDEXTENSION_VARIANT_TYPE_INT, Name: "damage"},
+		}, GDEXTENSION_VARIANT_TYPE_INT)
+	}, ClassVirtual())
+	if err != nil {
+		log.Panic("unable to register ExampleVirtual", zap.Error(err))
+	}
+}
+
+func UnregisterClassExampleVirtual() {
+	ClassDBUnregisterClass[*ExampleVirtual]()
+}
@@ -1,5 +1,7 @@
 package pkg
 
+//go:generate go run ../../cmd bindgen .
+
 /*
 #cgo CFLAGS: -I${SRCDIR} -I${SRCDIR}/../../godot_headers -I${SRCDIR}/../../pkg/log -I${SRCDIR}/../../pkg/gdextension
 */
@@ -11,18 +13,36 @@ import (
 	. "github.com/godot-go/godot-go/pkg/core"
 	"github.com/godot-go/godot-go/pkg/ffi"
 	"github.com/godot-go/godot-go/pkg/log"
+	"github.com/godot-go/godot-go/pkg/script"
 	"github.com/godot-go/godot-go/pkg/util"
 )
 
 func RegisterExampleTypes() {
 	log.Debug("RegisterExampleTypes called")
-	// RegisterClassExampleRef()
+	RegisterClassExampleRef()
+	RegisterClassExampleInternal()
 	RegisterClassExample()
+	RegisterClassExampleAuto()
+	RegisterClassExampleAbstract()
+	RegisterClassExampleRuntime()
+	RegisterClassExampleVirtual()
+	RegisterClassExampleSlots()
+	RegisterBehaviourSpinner()
+	script.Register()
 }
 
 func UnregisterExampleTypes() {
 	log.Debug("UnregisterExampleTypes called")
+	script.Unregister()
+	UnregisterBehaviourSpinner()
+	UnregisterClassExampleSlots()
+	UnregisterClassExampleVirtual()
+	UnregisterClassExampleRuntime()
+	UnregisterClassExampleAbstract()
+	UnregisterClassExampleAuto()
 	UnregisterClassExample()
+	UnregisterClassExampleInternal()
+	UnregisterClassExampleRef()
 }
 
 //export TestDemoInit
@@ -0,0 +1,43 @@
+package pkg
+
+import (
+	"fmt"
+
+	"github.com/godot-go/godot-go/pkg/log"
+	"github.com/godot-go/godot-go/pkg/script"
+	"go.uber.org/zap"
+)
+
+// Spinner is a behaviour attached to nodes with a GoScript.
+type Spinner struct {
+	script.BehaviourImpl
+	Speed float64 `godot:"property,hint=range,hint_string=0,360,1"`
+	Label string  `godot:"property"`
+	turns int64
+}
+
+func (s *Spinner) Spin(turns int64) int64 {
+	s.turns += turns
+	return s.turns
+}
+
+func (s *Spinner) Turns() int64 {
+	return s.turns
+}
+
+func (s *Spinner) String() string {
+	return fmt.Sprintf("Spinner(%s)", s.Label)
+}
+
+func RegisterBehaviourSpinner() {
+	err := script.RegisterBehaviour("Spinner", "Node", func() *Spinner {
+		return &Spinner{Speed: 90, Label: "spinner"}
+	})
+	if err != nil {
+		log.Panic("unable to register Spinner", zap.Error(err))
+	}
+}
+
+func UnregisterBehaviourSpinner() {
+	script.UnregisterBehaviour("Spinner")
+}